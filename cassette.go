@@ -0,0 +1,41 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// The encodings of the parameters and results of the calls recorded in cassettes
+const (
+	cassetteValue = "value" // encoded as is by encoding/json
+	cassetteError = "error" // an error, encoded as its message
+	cassetteOmit  = "omit"  // not encoded, e.g. a context.Context, func or channel
+)
+
+// cassette returns the encoding of values of the given type in cassettes
+func cassette(t Type) string {
+	switch actual := t.(type) {
+	case *Channel, *SendChannel, *ReceiveChannel:
+		return cassetteOmit
+	case *BasicType:
+		switch {
+		case actual.Qualifier == "" && actual.Name == "error":
+			return cassetteError
+		case actual.Path == "context" && actual.Name == "Context", actual.Qualifier == "context" && actual.Name == "Context":
+			return cassetteOmit
+		case actual.underlying != "":
+			return cassetteOmit
+		case actual.Qualifier == "" && strings.HasPrefix(actual.Name, "func"):
+			return cassetteOmit
+		case actual.Qualifier == "" && strings.HasPrefix(actual.Name, "interface") && strings.Replace(actual.Name, " ", "", -1) != "interface{}":
+			return cassetteOmit
+		}
+	}
+
+	return cassetteValue
+}
+
+// jsonTag returns the struct tag giving the name of a field encoded by encoding/json
+func jsonTag(name string) string {
+	return "`json:" + strconv.Quote(name) + "`"
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCassette(t *testing.T) {
+	assert.Equal(t, cassetteValue, cassette(&BasicType{Name: "string"}))
+	assert.Equal(t, cassetteValue, cassette(&BasicType{Name: "interface{}"}))
+	assert.Equal(t, cassetteValue, cassette(&Array{subType: &BasicType{Name: "int"}}))
+	assert.Equal(t, cassetteError, cassette(&BasicType{Name: "error"}))
+	assert.Equal(t, cassetteOmit, cassette(&BasicType{Name: "Context", Qualifier: "context", Path: "context"}))
+	assert.Equal(t, cassetteOmit, cassette(&BasicType{Name: "func(int) string"}))
+	assert.Equal(t, cassetteOmit, cassette(&BasicType{Name: "interface{ Close() error }"}))
+	assert.Equal(t, cassetteOmit, cassette(&BasicType{Name: "Reader", Qualifier: "io", Path: "io", underlying: "interface"}))
+	assert.Equal(t, cassetteOmit, cassette(&ReceiveChannel{subType: &BasicType{Name: "int"}}))
+}
+
+func TestJSONTag(t *testing.T) {
+	assert.Equal(t, "`json:\"ident1\"`", jsonTag("ident1"))
+}
+
+func TestGenerateCassettes(t *testing.T) {
+	g, err := parsePackage("testdata/cassetter", []string{"testdata/cassetter/cassetter_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Cassetter"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "MarshalJSON")
+
+	g.Features = AllFeatures
+	src, err = g.Generate([]string{"Cassetter"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "func (i CassetterFetchInvocation) MarshalJSON() ([]byte, error)")
+	assert.Contains(t, string(src), "func (i *CassetterFetchInvocation) UnmarshalJSON(data []byte) error")
+	assert.Regexp(t, `func NewFakeCassetterRecord\(t_sym\d+ interface`, string(src))
+	assert.Regexp(t, `func NewFakeCassetterReplay\(t_sym\d+ CassetterTestingT, path_sym\d+ string\)`, string(src))
+	assert.Contains(t, string(src), "func charlatanError(message *string) error {")
+	assert.NotContains(t, string(src), "`json:\"ctx\"`")
+	assert.NotContains(t, string(src), "`json:\"progress\"`")
+	assert.NotContains(t, string(src), "`json:\"events\"`")
+	assert.NotContains(t, string(src), "`json:\"r\"`")
+	assert.NotContains(t, string(src), "`json:\"cb\"`")
+
+	g.Features = Features{Cassettes: true}
+	src, err = g.Generate([]string{"Cassetter"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type CassetterFetchInvocation struct")
+	assert.Contains(t, string(src), "func NewFakeCassetterReplay(")
+
+	g.Features = AllFeatures
+	g.Features.Cassettes = false
+	src, err = g.Generate([]string{"Cassetter"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "json")
+	assert.NotContains(t, string(src), "charlatanCassette")
+	assert.NotContains(t, string(src), "NewFakeCassetterRecord")
+}
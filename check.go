@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// diffOutput compares generated source with the file at the given path.  It returns a unified diff if they differ, or
+// the empty string if they are the same.  A missing file is compared as empty.
+func diffOutput(path string, src []byte) (string, error) {
+	existing, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("error reading output: %s", err)
+	}
+
+	diff := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(existing)),
+		B:        difflib.SplitLines(string(src)),
+		FromFile: path,
+		ToFile:   path + " (generated)",
+		Context:  3,
+	}
+
+	return difflib.GetUnifiedDiffString(diff)
+}
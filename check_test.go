@@ -0,0 +1,86 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffOutput(t *testing.T) {
+	g, err := parsePackage("testdata/multireturner", []string{"testdata/multireturner/multireturner_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	g.CommandLine = "charlatan -dir=testdata/multireturner -output=testdata/multireturner/multireturner.go Multireturner"
+	src, err := g.Generate([]string{"Multireturner"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	diff, err := diffOutput("testdata/multireturner/multireturner.go", src)
+	assert.Nil(t, err)
+	assert.Equal(t, "", diff)
+
+	golden, err := ioutil.ReadFile("testdata/multireturner/multireturner.go")
+	if err != nil {
+		t.Fatalf("cannot read golden output: %s", err)
+	}
+	edited := strings.Replace(string(golden), "type FakeMultireturner struct {\n", "type FakeMultireturner struct { // edited\n", 1)
+	path := filepath.Join(t.TempDir(), "multireturner.go")
+	if err := ioutil.WriteFile(path, []byte(edited), 0644); err != nil {
+		t.Fatalf("cannot write edited output: %s", err)
+	}
+
+	diff, err = diffOutput(path, src)
+	assert.Nil(t, err)
+	assert.Contains(t, diff, "--- "+path+"\n")
+	assert.Contains(t, diff, "+++ "+path+" (generated)\n")
+	assert.Contains(t, diff, "\n-type FakeMultireturner struct { // edited\n+type FakeMultireturner struct {\n")
+	assert.Equal(t, 1, strings.Count(diff, "@@ -"))
+
+	diff, err = diffOutput("testdata/multireturner/missing.go", src)
+	assert.Nil(t, err)
+	assert.Contains(t, diff, "+type FakeMultireturner struct {\n")
+}
+
+func TestDiffOutputSame(t *testing.T) {
+	diff, err := diffOutput("testdata/_/__def.go", []byte("package main\n\ntype _ interface {\n\tUnder()\n}\n"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", diff)
+}
+
+// TestDiffOutputRegenerated checks that generating again, with the previous output in the package, makes no difference
+func TestDiffOutputRegenerated(t *testing.T) {
+	dir := t.TempDir()
+	def, err := ioutil.ReadFile("testdata/cassetter/cassetter_def.go")
+	if err != nil {
+		t.Fatalf("cannot read interface definition: %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "cassetter_def.go"), def, 0644); err != nil {
+		t.Fatalf("cannot write interface definition: %s", err)
+	}
+	output := filepath.Join(dir, "fake.go")
+
+	for i, expected := range []bool{true, false} {
+		g, err := LoadPackageDir(dir)
+		if err != nil {
+			t.Fatalf("LoadPackageDir error: %s", err)
+		}
+		g.Output = output
+		src, err := g.Generate([]string{"Cassetter"})
+		if err != nil {
+			t.Fatalf("Generator.Generate error: %s", err)
+		}
+
+		diff, err := diffOutput(output, src)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, diff != "", "generation %d", i+1)
+		if err := ioutil.WriteFile(output, src, 0644); err != nil {
+			t.Fatalf("cannot write output: %s", err)
+		}
+	}
+}
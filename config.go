@@ -0,0 +1,30 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// Config is read from the JSON file given by -config.  Flags take precedence over the values in the file.
+type Config struct {
+	Naming Naming `json:"naming"` // patterns for the names of the generated declarations
+}
+
+// LoadConfig reads a configuration file
+func LoadConfig(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load config: %s", err)
+	}
+
+	config := new(Config)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(config); err != nil {
+		return nil, fmt.Errorf("cannot load config %s: %s", path, err)
+	}
+
+	return config, nil
+}
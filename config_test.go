@@ -0,0 +1,20 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig("testdata/config/naming.json")
+
+	assert.Nil(t, err)
+	assert.Equal(t, Naming{Fake: "Mock{{.Interface}}", Hook: "On{{.Method}}"}, config.Naming)
+}
+
+func TestLoadConfigMissing(t *testing.T) {
+	_, err := LoadConfig("testdata/config/missing.json")
+
+	assert.Error(t, err)
+}
@@ -18,13 +18,30 @@ import (
 // after generating the mocks for its interface. The rule is that for
 // testdata/x.go we run `charlatan -dir=testdata X` and then compile
 // and run the testdata/x.go program. The resulting binary panics if the mock
-// structs are broken, including for error cases.
+// structs are broken, including for error cases. A program exercising a
+// feature of the fakes of X is named after both, e.g. testdata/x_sync.go.
 
 type endToEndTest struct {
 	exe  string
 	file string
 }
 
+// endToEndFlags are the flags of charlatan and of go run for the programs exercising the features that are not
+// generated by default, the other programs use the default features
+var endToEndFlags = map[string]struct{ charlatan, run []string }{
+	"cassetter_ete.go":             {charlatan: []string{"-features", "all"}},
+	"cassetter_errors_ete.go":      {charlatan: []string{"-features", "calls,stubs,sequences,strict"}},
+	"namedvaluer_matchers_ete.go":  {charlatan: []string{"-features", "calls,stubs,invocations,called,assert,matchers"}},
+	"namedvaluer_order_ete.go":     {charlatan: []string{"-features", "calls,assert,order"}},
+	"namedvaluer_sequences_ete.go": {charlatan: []string{"-features", "stubs,sequences"}},
+	"namedvaluer_spy_ete.go":       {charlatan: []string{"-features", "calls,constructors,called,sequences"}},
+	"namedvaluer_strict_ete.go":    {charlatan: []string{"-features", "stubs,sequences,invocations,invocation-ctors,matchers,strict"}},
+	"namedvaluer_sync_ete.go": {
+		charlatan: []string{"-features", "calls,stubs,called,sync"},
+		run:       []string{"-race"},
+	},
+}
+
 func (e *endToEndTest) compileAndRun(t *testing.T) {
 	t.Parallel()
 	tempdir, err := ioutil.TempDir("", "charlatan")
@@ -33,7 +50,7 @@ func (e *endToEndTest) compileAndRun(t *testing.T) {
 	}
 	defer os.RemoveAll(tempdir)
 
-	base := strings.TrimSuffix(path.Base(e.file), "_ete.go")
+	base := strings.SplitN(strings.TrimSuffix(path.Base(e.file), "_ete.go"), "_", 2)[0]
 	interfaceName := strings.Title(base)
 
 	sourceDef := filepath.Join(tempdir, base+"_def.go")
@@ -44,7 +61,9 @@ func (e *endToEndTest) compileAndRun(t *testing.T) {
 
 	charlatanSource := filepath.Join(tempdir, interfaceName+"_charlatan.go")
 	// Run charlatan in temporary directory.
-	err = run(e.exe, "-dir", tempdir, "-output", charlatanSource, "-package", "main", interfaceName)
+	flags := endToEndFlags[path.Base(e.file)]
+	args := append([]string{"-dir", tempdir, "-output", charlatanSource, "-package", "main"}, flags.charlatan...)
+	err = run(e.exe, append(args, interfaceName)...)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -56,7 +75,8 @@ func (e *endToEndTest) compileAndRun(t *testing.T) {
 	}
 
 	// Run the binary in the temporary directory.
-	err = run("go", "run", charlatanSource, sourceDef, source)
+	args = append(append([]string{"run"}, flags.run...), charlatanSource, sourceDef, source)
+	err = run("go", args...)
 	if err != nil {
 		t.Fatal(err)
 	}
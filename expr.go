@@ -92,6 +92,7 @@ func unwrapExpr(node ast.Expr, imports *ImportSet) (t Type, err error) {
 		t = &BasicType{
 			Qualifier: selector,
 			Name:      nodeType.Sel.Name,
+			Path:      imports.PathByName(selector),
 		}
 	case *ast.Ident:
 		t = &BasicType{
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Features selects the groups of declarations generated for each fake.  The hooks and the methods implementing the
+// interface are always generated.
+type Features struct {
+	Calls                  bool // the calls fields recording each invocation, and Reset
+	Constructors           bool // the default constructors, e.g. NewFakeXDefaultPanic
+	Stubs                  bool // the Set*Stub, Set*Error and FailAll methods
+	Sequences              bool // the Set*StubSequence and Set*StubOnCall methods, and the CharlatanExhausted declarations
+	Invocations            bool // the Set*Invocation methods
+	InvocationConstructors bool // the invocation constructors, e.g. NewXYInvocation
+	Called                 bool // the *Called, *NotCalled, *CalledOnce, *CalledN, *CalledWith and *CalledOnceWith methods
+	Assert                 bool // the Assert* methods
+	ResultsForCall         bool // the *ResultsForCall methods
+	Matchers               bool // the CharlatanMatcher declarations and the *CalledWithMatch, Assert*CalledWithMatch and Set*InvocationMatch methods
+	Order                  bool // the sequence numbers of the calls, CharlatanInOrder and the AssertCallOrder methods
+	Sync                   bool // a mutex guarding the hooks and calls, and the *CallsSnapshot methods
+	Strict                 bool // the NewFake*Strict constructors, verifying the configured stubs and invocations were used
+	Cassettes              bool // the JSON encoding of the invocations, and the NewFake*Record and NewFake*Replay constructors
+}
+
+// AllFeatures generates the complete fakes
+var AllFeatures = Features{
+	Calls:                  true,
+	Constructors:           true,
+	Stubs:                  true,
+	Sequences:              true,
+	Invocations:            true,
+	InvocationConstructors: true,
+	Called:                 true,
+	Assert:                 true,
+	ResultsForCall:         true,
+	Matchers:               true,
+	Order:                  true,
+	Sync:                   true,
+	Strict:                 true,
+	Cassettes:              true,
+}
+
+// DefaultFeatures are generated unless features are selected.  The features that add fields to the fakes or their
+// invocations, add methods or imports to the invocations, or declare names shared by the fakes of the package are
+// opt-in: the sequences, matchers, order, sync, strict and cassettes features.
+var DefaultFeatures = Features{
+	Calls:                  true,
+	Constructors:           true,
+	Stubs:                  true,
+	Invocations:            true,
+	InvocationConstructors: true,
+	Called:                 true,
+	Assert:                 true,
+	ResultsForCall:         true,
+}
+
+// featureNames are the names of the features used on the command line, in the order they are listed
+var featureNames = []struct {
+	name    string
+	feature func(*Features) *bool
+}{
+	{"calls", func(f *Features) *bool { return &f.Calls }},
+	{"constructors", func(f *Features) *bool { return &f.Constructors }},
+	{"stubs", func(f *Features) *bool { return &f.Stubs }},
+	{"sequences", func(f *Features) *bool { return &f.Sequences }},
+	{"invocations", func(f *Features) *bool { return &f.Invocations }},
+	{"invocation-ctors", func(f *Features) *bool { return &f.InvocationConstructors }},
+	{"called", func(f *Features) *bool { return &f.Called }},
+	{"assert", func(f *Features) *bool { return &f.Assert }},
+	{"results-for-call", func(f *Features) *bool { return &f.ResultsForCall }},
+	{"matchers", func(f *Features) *bool { return &f.Matchers }},
+	{"order", func(f *Features) *bool { return &f.Order }},
+	{"sync", func(f *Features) *bool { return &f.Sync }},
+	{"strict", func(f *Features) *bool { return &f.Strict }},
+	{"cassettes", func(f *Features) *bool { return &f.Cassettes }},
+}
+
+// ParseFeatures returns the features selected by comma separated lists of feature names, where "all" names every
+// feature.  If enabled is empty the DefaultFeatures are selected, then those in disabled are removed.
+func ParseFeatures(enabled, disabled string) (Features, error) {
+	features := DefaultFeatures
+	if strings.TrimSpace(enabled) != "" {
+		features = Features{}
+		if err := features.set(enabled, true); err != nil {
+			return Features{}, err
+		}
+	}
+	if err := features.set(disabled, false); err != nil {
+		return Features{}, err
+	}
+
+	for _, dependent := range []string{"called", "assert", "results-for-call", "order"} {
+		if *features.lookup(dependent) && !features.Calls {
+			return Features{}, fmt.Errorf("error: feature %q requires \"calls\"", dependent)
+		}
+	}
+
+	return features, nil
+}
+
+func (f *Features) set(list string, value bool) error {
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		switch {
+		case name == "":
+			continue
+		case name == "all":
+			for _, n := range featureNames {
+				*n.feature(f) = value
+			}
+			continue
+		case name == "hooks":
+			if !value {
+				return fmt.Errorf("error: feature \"hooks\" cannot be disabled")
+			}
+			continue
+		case f.lookup(name) == nil:
+			return fmt.Errorf("error: unknown feature %q, expected one of: %s", name, FeatureNames())
+		}
+		*f.lookup(name) = value
+	}
+
+	return nil
+}
+
+func (f *Features) lookup(name string) *bool {
+	for _, n := range featureNames {
+		if n.name == name {
+			return n.feature(f)
+		}
+	}
+
+	return nil
+}
+
+// FeatureNames returns the comma separated names of all the features
+func FeatureNames() string {
+	names := []string{"hooks"}
+	for _, n := range featureNames {
+		names = append(names, n.name)
+	}
+
+	return strings.Join(names, ",")
+}
+
+// InvocationTypes returns true if the invocation types are used by the selected features
+func (f Features) InvocationTypes() bool {
+	return f.Calls || f.Invocations || f.InvocationConstructors || f.Cassettes
+}
+
+// TestingT returns true if the TestingT interface is used by the selected features
+func (f Features) TestingT() bool {
+	return f.Constructors || f.Assert || f.Strict || f.Cassettes
+}
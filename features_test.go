@@ -0,0 +1,287 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFeatures(t *testing.T) {
+	features, err := ParseFeatures("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultFeatures, features)
+	assert.False(t, features.Sequences || features.Matchers || features.Order || features.Sync || features.Strict || features.Cassettes)
+
+	features, err = ParseFeatures("all", "")
+	assert.Nil(t, err)
+	assert.Equal(t, AllFeatures, features)
+
+	features, err = ParseFeatures("all", "sequences,matchers,order,sync,strict,cassettes")
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultFeatures, features)
+
+	features, err = ParseFeatures("hooks, calls,assert", "")
+	assert.Nil(t, err)
+	assert.Equal(t, Features{Calls: true, Assert: true}, features)
+
+	features, err = ParseFeatures("", "invocation-ctors,results-for-call")
+	assert.Nil(t, err)
+	expected := DefaultFeatures
+	expected.InvocationConstructors = false
+	expected.ResultsForCall = false
+	assert.Equal(t, expected, features)
+
+	_, err = ParseFeatures("hooks,mocks", "")
+	assert.EqualError(t, err, `error: unknown feature "mocks", expected one of: `+FeatureNames())
+
+	_, err = ParseFeatures("", "hooks")
+	assert.EqualError(t, err, `error: feature "hooks" cannot be disabled`)
+
+	_, err = ParseFeatures("", "calls")
+	assert.EqualError(t, err, `error: feature "called" requires "calls"`)
+}
+
+func TestGenerateFeatures(t *testing.T) {
+	g, err := parsePackage("testdata/namedvaluer", []string{"testdata/namedvaluer/namedvaluer_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	g.Features = Features{Calls: true, Assert: true}
+	src, err := g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "AssertNamedCalledWith(")
+	assert.Contains(t, string(src), `import "reflect"`)
+	assert.NotContains(t, string(src), "NamedCalledWith(a int, b string) bool")
+	assert.NotContains(t, string(src), "NewFakeNamedvaluerDefaultPanic")
+
+	g.Features = Features{Stubs: true}
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "SetNamedStub(")
+	assert.NotContains(t, string(src), "NewFakeNamedvaluerSpy")
+	assert.NotContains(t, string(src), "reflect")
+	assert.NotContains(t, string(src), "NamedvaluerNamedInvocation")
+	assert.NotContains(t, string(src), "NamedvaluerTestingT")
+}
+
+func TestGenerateSync(t *testing.T) {
+	g, err := parsePackage("testdata/namedvaluer", []string{"testdata/namedvaluer/namedvaluer_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "mutex")
+
+	g.Features = AllFeatures
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "mutex")
+	assert.Contains(t, string(src), "NamedCallsSnapshot()")
+
+	g.Features.Sync = false
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), `import "sync"`)
+	assert.NotContains(t, string(src), "mutex")
+	assert.NotContains(t, string(src), "Snapshot")
+}
+
+func TestGenerateMatchers(t *testing.T) {
+	g, err := parsePackage("testdata/namedvaluer", []string{"testdata/namedvaluer/namedvaluer_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "Matcher")
+
+	g.Features.Matchers = true
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type CharlatanMatcher interface")
+	assert.Contains(t, string(src), "NamedCalledWithMatch(")
+	assert.Contains(t, string(src), "SetNamedInvocationMatch(")
+	assert.NotRegexp(t, `func (Any|Eq|Not|Func|FieldsEq)\(`, string(src))
+}
+
+func TestGenerateOrder(t *testing.T) {
+	g, err := parsePackage("testdata/namedvaluer", []string{"testdata/namedvaluer/namedvaluer_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "Sequence")
+
+	g.Features = AllFeatures
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "func CharlatanInOrder(")
+	assert.NotRegexp(t, `(type Call|func InOrder)\b`, string(src))
+	assert.Contains(t, string(src), "AssertCallOrder(")
+
+	g.Features.Assert = false
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "func CharlatanInOrder(")
+	assert.NotContains(t, string(src), "AssertCallOrder")
+
+	g.Features.Order = false
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "CallSequence")
+	assert.NotContains(t, string(src), "InOrder")
+}
+
+func TestGenerateStubSequences(t *testing.T) {
+	g, err := parsePackage("testdata/namedvaluer", []string{"testdata/namedvaluer/namedvaluer_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "StubSequence")
+
+	g.Features = AllFeatures
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type CharlatanExhausted ")
+	assert.NotRegexp(t, `(type Exhausted|func (RepeatLast|PanicWhenExhausted|FailWhenExhausted))\b`, string(src))
+	assert.Contains(t, string(src), "SetNamedStubSequence(")
+	assert.Contains(t, string(src), "SetNamedStubOnCall(")
+
+	g.Features.Calls = false
+	g.Features.Called = false
+	g.Features.Assert = false
+	g.Features.ResultsForCall = false
+	g.Features.Order = false
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "SetNamedStubSequence(")
+	assert.Contains(t, string(src), "SetNamedStubOnCall(")
+	assert.NotContains(t, string(src), "since the fake was created or Reset")
+
+	g.Features.Sequences = false
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "Exhausted")
+	assert.NotContains(t, string(src), "StubSequence")
+}
+
+func TestGenerateStrict(t *testing.T) {
+	g, err := parsePackage("testdata/namedvaluer", []string{"testdata/namedvaluer/namedvaluer_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "Strict")
+
+	g.Features = AllFeatures
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "func NewFakeNamedvaluerStrict(")
+	assert.Contains(t, string(src), "charlatanExpect(")
+
+	g.TestingT = TestingTB
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Regexp(t, `func NewFakeNamedvaluerStrict\(t_sym\d+ testing.TB\)`, string(src))
+
+	g.Features = Features{Strict: true}
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), `import "testing"`)
+	assert.Contains(t, string(src), "func NewFakeNamedvaluerStrict(")
+
+	g.Features = AllFeatures
+	g.Features.Strict = false
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "Strict")
+	assert.NotContains(t, string(src), "charlatanExpect")
+}
+
+func TestGenerateDefaultZero(t *testing.T) {
+	g, err := parsePackage("testdata/cassetter", []string{"testdata/cassetter/cassetter_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Cassetter"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "func NewFakeCassetterDefaultZero(")
+	assert.Contains(t, string(src), "func NewFakeCassetterDefaultErr(")
+
+	g.Features.Constructors = false
+	src, err = g.Generate([]string{"Cassetter"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "DefaultZero")
+	assert.NotContains(t, string(src), "DefaultErr(")
+}
+
+func TestGenerateSpy(t *testing.T) {
+	g, err := parsePackage("testdata/embedder", []string{"testdata/embedder/embedder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Embedder"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "func NewFakeEmbedderSpy(")
+
+	g.Features.Constructors = false
+	src, err = g.Generate([]string{"Embedder"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "Spy")
+}
+
+func TestGenerateErrors(t *testing.T) {
+	g, err := parsePackage("testdata/cassetter", []string{"testdata/cassetter/cassetter_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Cassetter"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "ErrorOnCall")
+
+	g.Features = AllFeatures
+	src, err = g.Generate([]string{"Cassetter"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "SetFetchError(")
+	assert.Contains(t, string(src), "SetWatchErrorOnCall(")
+	assert.Contains(t, string(src), "FailAll(")
+
+	g.Features.Calls = false
+	g.Features.Called = false
+	g.Features.Assert = false
+	g.Features.ResultsForCall = false
+	g.Features.Order = false
+	src, err = g.Generate([]string{"Cassetter"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "SetFetchError(")
+	assert.Contains(t, string(src), "SetFetchErrorOnCall(")
+
+	g.Features.Stubs = false
+	src, err = g.Generate([]string{"Cassetter"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "SetFetchError(")
+	assert.NotContains(t, string(src), "FailAll")
+
+	g, err = parsePackage("testdata/namedvaluer", []string{"testdata/namedvaluer/namedvaluer_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	src, err = g.Generate([]string{"Namedvaluer"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "Error(err error)")
+	assert.NotContains(t, string(src), "FailAll")
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"flag"
 	"fmt"
 	"go/ast"
 	"go/build"
@@ -10,17 +9,57 @@ import (
 	"go/types"
 	"log"
 	"os"
+	"os/exec"
+	pathpkg "path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"text/template"
 )
 
+const commonFilename = "charlatan.go"
+
 // LoadPackageDir parses a package in the given directory.
 func LoadPackageDir(directory string) (*Generator, error) {
-	pkg, err := build.Default.ImportDir(directory, 0)
+	names, err := packageFiles(directory)
 	if err != nil {
 		return nil, fmt.Errorf("cannot process directory %s: %s", directory, err)
 	}
+
+	return parsePackage(directory, names)
+}
+
+// LoadPackageSource parses a package in the given directory, using the given source for the named file instead of its
+// contents on disk.  The file does not need to exist, e.g. an unsaved editor buffer, but must be named so that it
+// replaces the file of the package it was read from.
+func LoadPackageSource(directory, filename string, src []byte) (*Generator, error) {
+	if filename == "" {
+		return nil, fmt.Errorf("error: the file name of the source is required")
+	}
+	names, err := packageFiles(directory)
+	if _, noGo := err.(*build.NoGoError); err != nil && !noGo {
+		return nil, fmt.Errorf("cannot process directory %s: %s", directory, err)
+	}
+
+	if filepath.Base(filename) == filename {
+		filename = filepath.Join(directory, filename)
+	}
+	for i, name := range names {
+		if filepath.Clean(name) == filepath.Clean(filename) {
+			names = append(names[:i], names[i+1:]...)
+			break
+		}
+	}
+	names = append(names, filename)
+
+	return parsePackageSources(directory, names, map[string][]byte{filename: src})
+}
+
+func packageFiles(directory string) ([]string, error) {
+	pkg, err := build.Default.ImportDir(directory, 0)
+	if err != nil {
+		return nil, err
+	}
 	names := make([]string, 0, len(pkg.GoFiles)+len(pkg.CgoFiles))
 	names = append(names, pkg.GoFiles...)
 	names = append(names, pkg.CgoFiles...)
@@ -31,30 +70,54 @@ func LoadPackageDir(directory string) (*Generator, error) {
 		}
 	}
 
-	return parsePackage(directory, names)
+	return names, nil
 }
 
 func parsePackage(directory string, filenames []string) (*Generator, error) {
+	return parsePackageSources(directory, filenames, nil)
+}
+
+// parsePackageSources parses the named files, the source of a file is read from sources if present
+func parsePackageSources(directory string, filenames []string, sources map[string][]byte) (*Generator, error) {
+	fileset := token.NewFileSet()
+	importer := defaultImporter(fileset)
 	generator := &Generator{
+		directory:  directory,
+		fileset:    fileset,
+		importer:   importer,
 		imports:    new(ImportSet),
 		interfaces: make(map[string]*Interface),
+		docs:       make(map[string]map[int]string),
+		generated:  make(map[string]bool),
+		warned:     make(map[string]bool),
+		Features:   DefaultFeatures,
+		TestingT:   TestingTInterface,
 	}
 	files := make([]*ast.File, 0, len(filenames))
-	fileset := token.NewFileSet()
-	importer := defaultImporter()
 
 	for _, filename := range filenames {
 		if !strings.HasSuffix(filename, ".go") {
 			continue
 		}
-		file, err := parser.ParseFile(fileset, filename, nil, 0)
+		var src interface{}
+		if data, ok := sources[filename]; ok {
+			src = data
+		}
+		file, err := parser.ParseFile(fileset, filename, src, parser.ParseComments)
 		if err != nil {
 			return nil, fmt.Errorf("syntax error: %s", err)
 		}
-		if err := generator.processImports(file, importer); err != nil {
-			return nil, err
+		// N.B. - the doc comments of the package's own methods are taken from its syntax trees
+		generator.docs[filename] = nil
+		if isGenerated(file) {
+			// N.B. - previously generated fakes are type checked with the package, but their imports and
+			// declarations must not change the output, which would differ from a generation without them
+			generator.generated[filename] = true
+			files = append(files, file)
+			continue
 		}
-		if err := generator.processInterfaces(file); err != nil {
+		failed := generator.processImports(file, importer)
+		if err := generator.processInterfaces(file, failed); err != nil {
 			return nil, err
 		}
 		files = append(files, file)
@@ -63,14 +126,24 @@ func parsePackage(directory string, filenames []string) (*Generator, error) {
 		return nil, fmt.Errorf("error: no Go files found in %s", directory)
 	}
 
-	// N.B. - type check the package
-	config := types.Config{Importer: importer, Error: func(err error) { fmt.Fprintln(os.Stderr, err) }}
-	pkg, err := config.Check(directory, fileset, files, nil)
-	if err != nil {
-		return nil, fmt.Errorf("type check failed")
-	}
+	// N.B. - type check the package, errors are recorded to allow best effort generation
+	config := types.Config{Importer: importer, Error: generator.addError}
+	pkg, _ := config.Check(directory, fileset, files, nil)
 
 	generator.packageName = pkg.Name()
+	generator.files = files
+	generator.pkg = pkg
+	for _, decl := range generator.interfaces {
+		if decl.importName != "" {
+			continue
+		}
+		if obj, ok := pkg.Scope().Lookup(decl.Name).(*types.TypeName); ok {
+			decl.typ, _ = obj.Type().Underlying().(*types.Interface)
+		}
+		if decl.typ != nil {
+			decl.annotateMethods(pkg)
+		}
+	}
 
 	return generator, nil
 }
@@ -79,30 +152,98 @@ func parsePackage(directory string, filenames []string) (*Generator, error) {
 type Generator struct {
 	// PackageOverride can be set to control the package for the output file.  The default is the same package as the input interface(s).
 	PackageOverride string
-	packageName     string
-	imports         *ImportSet
-	interfaces      map[string]*Interface
+	// BestEffort can be set to generate the interfaces that type checked cleanly when the package has errors.  The default is to fail.
+	BestEffort bool
+	// Template can be set to produce the output file using a user supplied template.  The default is the built-in template.
+	Template *template.Template
+	// Naming can be set to control the names of the generated declarations.  Empty patterns use the default.
+	Naming Naming
+	// Features can be set to select the groups of declarations generated for each fake.  The default is DefaultFeatures.
+	Features Features
+	// SelfTest can be set to have GenerateFiles produce a companion test for each file.  The default is none.
+	SelfTest bool
+	// TestingT can be set to select the type of the testing parameters of the fakes.  The default is an interface per fake.
+	TestingT TestingT
+	// Output can be set to the path of the output file, or the output directory of GenerateFiles, to omit the shared
+	// declarations when another file in the directory already declares them.  The default is to always declare them.
+	Output string
+	// CommandLine can be set to the command recorded in the header of the output.  The default is "charlatan" and the interface names.
+	CommandLine string
+	// Header can be set to a comment, e.g. a license, prepended to the output.  The default is none.
+	Header string
+	// BuildTags can be set to a build constraint expression written as a //go:build line of the output.  The default is none.
+	BuildTags   string
+	directory   string
+	fileset     *token.FileSet
+	importer    types.Importer
+	files       []*ast.File
+	pkg         *types.Package // the type checked package
+	packageName string
+	imports     *ImportSet
+	interfaces  map[string]*Interface
+	errors      []error
+	docs        map[string]map[int]string // method doc comments of imported files, by file name and offset
+	generated   map[string]bool           // names of the files previously generated by charlatan
+	warned      map[string]bool           // the warnings already logged, the interfaces are resolved for each output
+}
+
+// warnf logs a warning unless it was already logged
+func (g *Generator) warnf(format string, args ...interface{}) {
+	warning := fmt.Sprintf(format, args...)
+	if g.warned[warning] {
+		return
+	}
+	g.warned[warning] = true
+	log.Println(warning)
 }
 
-func (g *Generator) processImports(file *ast.File, importer types.Importer) error {
+// addError records an error found while loading the package and marks the interface containing it, if any
+func (g *Generator) addError(err error) {
+	typeErr, ok := err.(types.Error)
+	if ok && g.generated[g.fileset.PositionFor(typeErr.Pos, false).Filename] {
+		// N.B. - previously generated fakes are stale when their interfaces change, they are about to be replaced
+		return
+	}
+
+	fmt.Fprintln(os.Stderr, err)
+	g.errors = append(g.errors, err)
+
+	if !ok {
+		return
+	}
+	for _, decl := range g.interfaces {
+		if decl.err == nil && decl.pos <= typeErr.Pos && typeErr.Pos < decl.end {
+			decl.err = fmt.Errorf("type check failed: %s", typeErr.Msg)
+		}
+	}
+}
+
+// processImports returns the paths of the imports that failed, by their names in the file
+func (g *Generator) processImports(file *ast.File, importer types.Importer) map[string]string {
+	failed := make(map[string]string)
 	for _, spec := range file.Imports {
 		path, err := strconv.Unquote(spec.Path.Value)
 		if err != nil {
-			return err
+			g.addError(err)
+			continue
 		}
 		pkg, err := importer.Import(path)
 		if err != nil {
-			return err
+			g.addError(err)
+			// N.B. - the package name is unknown, assume it is the last element of the path
+			name := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			failed[name] = path
+			continue
 		}
 
 		g.processImport(spec, pkg)
-		if err := g.processImportInterfaces(pkg); err != nil {
-			return err
-		}
-
+		g.processImportInterfaces(pkg)
 	}
 
-	return nil
+	return failed
 }
 
 func (g *Generator) processImport(spec *ast.ImportSpec, pkg *types.Package) {
@@ -129,7 +270,7 @@ func (g *Generator) processImport(spec *ast.ImportSpec, pkg *types.Package) {
 	g.imports.Add(decl)
 }
 
-func (g *Generator) processImportInterfaces(pkg *types.Package) error {
+func (g *Generator) processImportInterfaces(pkg *types.Package) {
 	for _, name := range pkg.Scope().Names() {
 		obj := pkg.Scope().Lookup(name)
 
@@ -144,7 +285,9 @@ func (g *Generator) processImportInterfaces(pkg *types.Package) error {
 
 		ifType := obj.Type().Underlying().(*types.Interface)
 		decl := &Interface{
-			Name: obj.Name(),
+			Name:       obj.Name(),
+			importName: pkg.Name(),
+			typ:        ifType,
 		}
 
 		for i := 0; i < ifType.NumMethods(); i++ {
@@ -153,17 +296,19 @@ func (g *Generator) processImportInterfaces(pkg *types.Package) error {
 				continue
 			}
 			if err := decl.addMethodFromType(m, g.imports); err != nil {
-				return err
+				// N.B. - only an error if the interface is requested
+				decl.err = err
+				break
 			}
 		}
 
 		g.interfaces[qname] = decl
 	}
-
-	return nil
 }
 
-func (g *Generator) processInterfaces(file *ast.File) error {
+// processInterfaces records the interfaces declared in the file, an interface referring to a package whose import
+// failed is marked broken since the type checker does not report the invalid types derived from that package
+func (g *Generator) processInterfaces(file *ast.File, failed map[string]string) error {
 	for _, node := range file.Decls {
 		gen, ok := node.(*ast.GenDecl)
 		if !ok || gen.Tok != token.TYPE {
@@ -179,6 +324,18 @@ func (g *Generator) processInterfaces(file *ast.File) error {
 		if err != nil {
 			return err
 		}
+		decl.pos = spec.Pos()
+		decl.end = spec.End()
+		ast.Inspect(ifType, func(node ast.Node) bool {
+			selector, ok := node.(*ast.SelectorExpr)
+			if !ok || decl.err != nil {
+				return decl.err == nil
+			}
+			if ident, ok := selector.X.(*ast.Ident); ok && failed[ident.Name] != "" {
+				decl.err = fmt.Errorf("type check failed: could not import %s", failed[ident.Name])
+			}
+			return true
+		})
 		g.interfaces[spec.Name.Name] = decl
 	}
 
@@ -215,65 +372,418 @@ func (g *Generator) processInterface(name string, ifType *ast.InterfaceType) (*I
 
 // Generate produces the charlatan source file data for the named interfaces.
 func (g *Generator) Generate(interfaceNames []string) ([]byte, error) {
+	decls, err := g.resolve(interfaceNames)
+	if err != nil {
+		return nil, err
+	}
+	imports, err := g.outputImports(decls)
+	if err != nil {
+		return nil, err
+	}
+	declared, err := g.sharedDeclared(g.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := charlatanTemplate{
+		CommandLine: g.commandLine(interfaceNames),
+		Header:      g.Header,
+		BuildTags:   g.BuildTags,
+		PackageName: g.outputPackageName(),
+		Imports:     imports,
+		Interfaces:  decls,
+		Shared:      true,
+		Features:    g.Features,
+		TestingT:    g.TestingT,
+		declared:    declared,
+		template:    g.Template,
+	}
+
+	return tmpl.execute()
+}
+
+// GenerateFiles produces a charlatan source file for each of the named interfaces, keyed by the file name produced
+// by the given pattern template.  The declarations shared by the fakes are produced in a common file if needed, and
+// not already declared by another file in the Output directory.
+func (g *Generator) GenerateFiles(interfaceNames []string, pattern string) (map[string][]byte, error) {
+	filenames, err := template.New("filename").Funcs(funky).Parse(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("error: invalid filename pattern: %s", err)
+	}
+
+	decls, err := g.resolve(interfaceNames)
+	if err != nil {
+		return nil, err
+	}
+
+	var declared map[string]bool
+	if g.Output != "" {
+		declared, err = g.sharedDeclared(filepath.Join(g.Output, commonFilename))
+		if err != nil {
+			return nil, err
+		}
+	}
+	common := charlatanTemplate{
+		CommandLine: g.commandLine(interfaceNames),
+		Header:      g.Header,
+		BuildTags:   g.BuildTags,
+		PackageName: g.outputPackageName(),
+		Shared:      true,
+		Features:    g.Features,
+		TestingT:    g.TestingT,
+		declared:    declared,
+		template:    g.Template,
+	}
+	src, err := common.execute()
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte, len(decls)+1)
+	if hasDeclarations(src) {
+		files[commonFilename] = src
+	}
+
+	for _, decl := range decls {
+		var name strings.Builder
+		if err := filenames.Execute(&name, decl); err != nil {
+			return nil, fmt.Errorf("error: invalid filename pattern: %s", err)
+		}
+		filename := name.String()
+		if _, exists := files[filename]; exists {
+			return nil, fmt.Errorf("error: filename pattern produced %s more than once", filename)
+		}
+
+		imports, err := g.outputImports([]*Interface{decl})
+		if err != nil {
+			return nil, err
+		}
+		tmpl := charlatanTemplate{
+			CommandLine: g.commandLine(interfaceNames),
+			Header:      g.Header,
+			BuildTags:   g.BuildTags,
+			PackageName: g.outputPackageName(),
+			Imports:     imports,
+			Interfaces:  []*Interface{decl},
+			Features:    g.Features,
+			TestingT:    g.TestingT,
+			template:    g.Template,
+		}
+		src, err := tmpl.execute()
+		if err != nil {
+			return nil, err
+		}
+		files[filename] = src
+
+		if g.SelfTest {
+			test := tmpl
+			test.template = selfTestTmpl
+			src, err := test.execute()
+			if err != nil {
+				return nil, err
+			}
+			testFilename := strings.TrimSuffix(filename, ".go") + "_test.go"
+			if _, exists := files[testFilename]; exists {
+				return nil, fmt.Errorf("error: filename pattern produced %s more than once", testFilename)
+			}
+			files[testFilename] = src
+		}
+	}
+
+	return files, nil
+}
+
+// GenerateTest produces a companion test for the fakes of the named interfaces, which checks that each fake
+// implements its interface and exercises the default constructors and Reset.
+func (g *Generator) GenerateTest(interfaceNames []string) ([]byte, error) {
+	decls, err := g.resolve(interfaceNames)
+	if err != nil {
+		return nil, err
+	}
+	imports, err := g.outputImports(decls)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := charlatanTemplate{
+		CommandLine: g.commandLine(interfaceNames),
+		Header:      g.Header,
+		BuildTags:   g.BuildTags,
+		PackageName: g.outputPackageName(),
+		Imports:     imports,
+		Interfaces:  decls,
+		Features:    g.Features,
+		TestingT:    g.TestingT,
+		template:    selfTestTmpl,
+	}
+
+	return tmpl.execute()
+}
+
+// isGenerated returns true if the file was generated by charlatan
+func isGenerated(file *ast.File) bool {
+	for _, comment := range file.Comments {
+		if comment.Pos() > file.Package {
+			break
+		}
+		for _, line := range strings.Split(comment.Text(), "\n") {
+			// N.B. - files generated by earlier versions lack the "Code " prefix
+			line = strings.TrimPrefix(line, "Code ")
+			if strings.HasPrefix(line, `generated by "charlatan`) && strings.HasSuffix(line, "DO NOT EDIT.") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hasDeclarations returns true if the given source declares anything besides imports
+func hasDeclarations(src []byte) bool {
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return true
+	}
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); !ok || gen.Tok != token.IMPORT {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolve returns the named interfaces with the methods of any embedded interfaces included
+func (g *Generator) resolve(interfaceNames []string) ([]*Interface, error) {
+	if len(g.errors) != 0 && !g.BestEffort {
+		return nil, fmt.Errorf("type check failed")
+	}
+
+	naming, err := newNamer(g.Naming)
+	if err != nil {
+		return nil, err
+	}
+
 	decls := make([]*Interface, 0, len(interfaceNames))
+	var sourcePath string
 	for _, name := range interfaceNames {
 		decl, ok := g.interfaces[name]
 		if !ok {
 			return nil, fmt.Errorf("error: interface %q not found", name)
 		}
-		if len(decl.Methods) == 0 {
-			log.Printf("warning: ignoring empty interface %q\n", decl.Name)
+		if err := g.checkInterface(decl); err != nil {
+			if !g.BestEffort {
+				return nil, fmt.Errorf("error: interface %q: %s", name, err)
+			}
+			g.warnf("warning: ignoring interface %q: %s", name, err)
 			continue
 		}
-		if decl.Name == "_" {
-			log.Println(`warning: ignorning interface named "_"`)
+		if len(decl.Methods) == 0 {
+			g.warnf("warning: ignoring empty interface %q", decl.Name)
 			continue
 		}
-		decls = append(decls, decl)
-		if len(decl.embeds) == 0 {
+		if decl.Name == "_" {
+			g.warnf(`warning: ignorning interface named "_"`)
 			continue
 		}
 
-		embeddedMethods := []*Method{}
+		methods := []*Method{}
 		for _, embedName := range decl.embeds {
 			embed, ok := g.interfaces[embedName]
 			if !ok {
 				return nil, fmt.Errorf("error: interface %q embedded in %s not found", embedName, name)
 			}
-
-			for _, m := range embed.Methods {
-				c := *m
-				c.Interface = decl.Name
-				embeddedMethods = append(embeddedMethods, &c)
+			methods = append(methods, embed.Methods...)
+		}
+		methods = append(methods, decl.Methods...)
+
+		// N.B. - copy the declaration to allow the interface to be resolved more than once
+		resolved := *decl
+		resolved.naming = naming
+		switch {
+		case decl.importName != "":
+			g.imports.RequireByName(decl.importName)
+			resolved.qualifier = g.imports.QualifierByName(decl.importName)
+		case g.outputPackageName() != g.packageName:
+			resolved.qualifier = g.packageName
+		}
+		resolved.Methods = make([]*Method, len(methods))
+		for i, m := range methods {
+			c := *m
+			c.Interface = decl.Name
+			c.naming = naming
+			if c.Doc == "" {
+				c.Doc = g.methodDoc(m.pos)
+			}
+			if resolved.qualifier != "" && decl.importName == "" {
+				if sourcePath == "" {
+					sourcePath, _ = packageImportPath(g.directory)
+				}
+				c.Parameters = g.qualifyIdentifiers(m.Parameters, sourcePath)
+				c.Results = g.qualifyIdentifiers(m.Results, sourcePath)
 			}
+			resolved.Methods[i] = &c
 		}
-		decl.Methods = append(embeddedMethods, decl.Methods...)
+		decls = append(decls, &resolved)
 	}
 
 	if len(decls) == 0 {
 		return nil, fmt.Errorf("error: no valid interface names provided")
 	}
 
-	packageName := g.packageName
+	return decls, nil
+}
+
+// qualifyIdentifiers returns copies of the identifiers whose types declared in the input package are qualified by its
+// name, for output in another package.  The path is the import path of the input package.
+func (g *Generator) qualifyIdentifiers(idents []*Identifier, path string) []*Identifier {
+	local := func(name string) bool {
+		if g.pkg == nil {
+			return false
+		}
+		_, ok := g.pkg.Scope().Lookup(name).(*types.TypeName)
+		return ok
+	}
+
+	qualified := make([]*Identifier, len(idents))
+	for i, ident := range idents {
+		qualified[i] = &Identifier{Name: ident.Name, ValueType: qualifyType(ident.ValueType, g.packageName, path, local)}
+	}
+
+	return qualified
+}
+
+// methodDoc returns the doc comment of the imported interface method declared at the given position.  The importer
+// does not retain comments, so the declaring file is parsed again.
+func (g *Generator) methodDoc(pos token.Pos) string {
+	if !pos.IsValid() {
+		return ""
+	}
+	position := g.fileset.Position(pos)
+
+	docs, ok := g.docs[position.Filename]
+	if !ok {
+		docs = make(map[int]string)
+		fileset := token.NewFileSet()
+		file, err := parser.ParseFile(fileset, position.Filename, nil, parser.ParseComments)
+		if err == nil {
+			ast.Inspect(file, func(node ast.Node) bool {
+				if ifType, ok := node.(*ast.InterfaceType); ok {
+					for _, field := range ifType.Methods.List {
+						if len(field.Names) != 0 && field.Doc != nil {
+							docs[fileset.Position(field.Names[0].Pos()).Offset] = field.Doc.Text()
+						}
+					}
+				}
+				return true
+			})
+		}
+		g.docs[position.Filename] = docs
+	}
+
+	return docs[position.Offset]
+}
+
+// outputImports returns the imports required by the output source for the given interfaces
+func (g *Generator) outputImports(decls []*Interface) ([]*Import, error) {
+	imports := g.requiredImports(decls)
+	for _, decl := range decls {
+		if decl.importName != "" || decl.qualifier == "" {
+			continue
+		}
+
+		// N.B. - a local interface is qualified when the output is in another package
+		path, err := packageImportPath(g.directory)
+		if err != nil {
+			return nil, err
+		}
+		source := &Import{Name: g.packageName, Path: strconv.Quote(path), Required: true}
+		if pathpkg.Base(path) != g.packageName {
+			source.Alias = g.packageName
+		}
+		return append(imports, source), nil
+	}
+
+	return imports, nil
+}
+
+// requiredImports returns the required imports of the packages referred to by the methods of the interfaces, leaving
+// out those required by the other interfaces of the package
+func (g *Generator) requiredImports(decls []*Interface) []*Import {
+	paths := make(map[string]bool)
+	names := make(map[string]bool)
+	for _, decl := range decls {
+		if decl.typ == nil {
+			return g.imports.GetRequired()
+		}
+		if decl.importName != "" {
+			names[decl.importName] = true
+		}
+		for i := 0; i < decl.typ.NumMethods(); i++ {
+			typePackages(decl.typ.Method(i).Type(), paths)
+		}
+	}
+
+	imports := []*Import{}
+	for _, imp := range g.imports.GetRequired() {
+		path, err := strconv.Unquote(imp.Path)
+		if err != nil {
+			path = imp.Path
+		}
+		if paths[path] || names[imp.Name] {
+			imports = append(imports, imp)
+		}
+	}
+
+	return imports
+}
+
+// packageImportPath returns the import path of the package in the given directory
+func packageImportPath(directory string) (string, error) {
+	pkg, err := build.Default.ImportDir(directory, build.FindOnly)
+	if err == nil && pkg.ImportPath != "." && !strings.HasPrefix(pkg.ImportPath, "_") {
+		return pkg.ImportPath, nil
+	}
+
+	// N.B. - outside of GOPATH the import path is only known to the go command
+	cmd := exec.Command("go", "list", "-f", "{{.ImportPath}}", ".")
+	cmd.Dir = directory
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("error: cannot determine the import path of %s: %s", directory, err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+func (g *Generator) outputPackageName() string {
 	if g.PackageOverride != "" {
-		packageName = g.PackageOverride
+		return g.PackageOverride
 	}
 
-	var argv strings.Builder
-	argv.WriteString("charlatan")
-	flag.Visit(func(f *flag.Flag) {
-		fmt.Fprintf(&argv, " -%s=%s", f.Name, f.Value)
-	})
-	if flag.NArg() > 0 {
-		argv.WriteByte(' ')
-		argv.WriteString(strings.Join(flag.Args(), " "))
+	return g.packageName
+}
+
+// commandLine returns the command recorded in the header of the output
+func (g *Generator) commandLine(interfaceNames []string) string {
+	if g.CommandLine != "" {
+		return g.CommandLine
 	}
-	tmpl := charlatanTemplate{
-		CommandLine: argv.String(),
-		PackageName: packageName,
-		Imports:     g.imports.GetRequired(),
-		Interfaces:  decls,
+
+	return strings.Join(append([]string{"charlatan"}, interfaceNames...), " ")
+}
+
+// checkInterface returns the first error recorded for the interface or any interface it embeds
+func (g *Generator) checkInterface(decl *Interface) error {
+	if decl.err != nil {
+		return decl.err
+	}
+	for _, embedName := range decl.embeds {
+		if embed, ok := g.interfaces[embedName]; ok && embed.err != nil {
+			return fmt.Errorf("embedded interface %s: %s", embedName, embed.err)
+		}
 	}
 
-	return tmpl.execute()
+	return nil
 }
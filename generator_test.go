@@ -1,8 +1,14 @@
 package main
 
 import (
-	"github.com/stretchr/testify/assert"
+	"bytes"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestLoadPackageDir(t *testing.T) {
@@ -11,3 +17,182 @@ func TestLoadPackageDir(t *testing.T) {
 	assert.Equal(t, err, nil)
 	assert.IsType(t, Generator{}, *g)
 }
+
+func TestLoadPackageSource(t *testing.T) {
+	src := []byte("package main\n\ntype Multireturner interface {\n\tRenamed() (int, error)\n}\n")
+	g, err := LoadPackageSource("testdata/multireturner", "multireturner_def.go", src)
+	if err != nil {
+		t.Fatalf("LoadPackageSource error: %s", err)
+	}
+
+	out, err := g.Generate([]string{"Multireturner"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(out), "func (f *FakeMultireturner) RenamedCalled() bool")
+	assert.NotContains(t, string(out), "MultiReturn")
+}
+
+func TestLoadPackageSourceEditedFile(t *testing.T) {
+	def, err := ioutil.ReadFile("testdata/embedder/embedder_def.go")
+	if err != nil {
+		t.Fatalf("cannot read source: %s", err)
+	}
+	src := bytes.Replace(def, []byte("type Embedder interface {\n"), []byte("type Embedder interface {\n\tEdited() bool\n"), 1)
+
+	g, err := LoadPackageSource("testdata/embedder", "embedder_def.go", src)
+	if err != nil {
+		t.Fatalf("LoadPackageSource error: %s", err)
+	}
+	out, err := g.Generate([]string{"Embedder"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(out), "func (f *FakeEmbedder) EditedCalled() bool")
+
+	_, err = LoadPackageSource("testdata/embedder", "", src)
+	assert.EqualError(t, err, "error: the file name of the source is required")
+}
+
+func TestGenerateImportedDocComments(t *testing.T) {
+	src := []byte("package main\n\nimport \"net\"\n\ntype Conner interface {\n\tnet.Conn\n\tOther()\n}\n")
+	g, err := LoadPackageSource("testdata/multireturner", "conner.go", src)
+	if err != nil {
+		t.Fatalf("LoadPackageSource error: %s", err)
+	}
+
+	out, err := g.Generate([]string{"Conner"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(out), "\n// Read reads data from the connection.")
+	assert.Contains(t, string(out), "\n\t// Close closes the connection.")
+}
+
+func TestGenerateBestEffort(t *testing.T) {
+	g, err := parsePackage("testdata/broken", []string{"testdata/broken/broken_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	_, err = g.Generate([]string{"Broken"})
+	assert.EqualError(t, err, "type check failed")
+
+	g.BestEffort = true
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	src, err := g.Generate([]string{"Broken"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type FakeBroken struct")
+
+	src, err = g.Generate([]string{"Broken", "Unresolved", "Embedder"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type FakeBroken struct")
+	assert.NotContains(t, string(src), "type FakeUnresolved struct")
+	assert.NotContains(t, string(src), "type FakeEmbedder struct")
+
+	src, err = g.Generate([]string{"Broken", "Importer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type FakeBroken struct")
+	assert.NotContains(t, string(src), "type FakeImporter struct")
+
+	_, err = g.Generate([]string{"Importer"})
+	assert.EqualError(t, err, "error: no valid interface names provided")
+
+	_, err = g.Generate([]string{"Unresolved"})
+	assert.EqualError(t, err, "error: no valid interface names provided")
+	assert.Equal(t, 1, strings.Count(logged.String(), `warning: ignoring interface "Importer"`))
+}
+
+func TestGenerateFiles(t *testing.T) {
+	g, err := parsePackage("testdata/embedder", []string{"testdata/embedder/embedder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	g.Features = AllFeatures
+	files, err := g.GenerateFiles([]string{"Embedder", "Embeddable"}, "fake_{{.Name | lower}}.go")
+	if err != nil {
+		t.Fatalf("Generator.GenerateFiles error: %s", err)
+	}
+
+	if assert.Len(t, files, 3) {
+		assert.Contains(t, string(files[commonFilename]), "type CharlatanMatcher interface")
+		assert.NotContains(t, string(files["fake_embedder.go"]), "type CharlatanMatcher interface")
+		assert.Contains(t, string(files["fake_embedder.go"]), "type FakeEmbedder struct")
+		assert.NotContains(t, string(files["fake_embedder.go"]), "type FakeEmbeddable struct")
+		assert.Contains(t, string(files["fake_embeddable.go"]), "type FakeEmbeddable struct")
+	}
+
+	_, err = g.GenerateFiles([]string{"Embedder", "Embeddable"}, "fake.go")
+	assert.EqualError(t, err, "error: filename pattern produced fake.go more than once")
+}
+
+func TestGenerateFilesSelfTest(t *testing.T) {
+	g, err := parsePackage("testdata/embedder", []string{"testdata/embedder/embedder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	g.SelfTest = true
+
+	files, err := g.GenerateFiles([]string{"Embedder", "Embeddable"}, "fake_{{.Name | lower}}.go")
+	if err != nil {
+		t.Fatalf("Generator.GenerateFiles error: %s", err)
+	}
+
+	assert.NotContains(t, files, commonFilename)
+	if assert.Len(t, files, 4) {
+		assert.Contains(t, string(files["fake_embedder_test.go"]), "func TestFakeEmbedderSelfCheck(t *testing.T)")
+		assert.Contains(t, string(files["fake_embeddable_test.go"]), "func TestFakeEmbeddableSelfCheck(t *testing.T)")
+	}
+}
+
+func TestGenerateTest(t *testing.T) {
+	g, err := parsePackage("testdata/variadic", []string{"testdata/variadic/variadic_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.GenerateTest([]string{"Variadic"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "panics.MixedVariadic(0, 0, 0)")
+	assert.Contains(t, string(src), "fatals := NewFakeVariadicDefaultFatal(fatalT)")
+	assert.Contains(t, string(src), "f.Reset()")
+
+	g.Features = Features{Stubs: true}
+	src, err = g.GenerateTest([]string{"Variadic"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "var _ Variadic = new(FakeVariadic)")
+	assert.NotContains(t, string(src), "panics")
+	assert.NotContains(t, string(src), "f.Reset()")
+}
+
+func TestGenerateConformance(t *testing.T) {
+	g, err := parsePackage("testdata/embedder", []string{"testdata/embedder/embedder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Embedder", "fmt.Stringer"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "var _ Embedder = (*FakeEmbedder)(nil)")
+	assert.Contains(t, string(src), "var _ fmt.Stringer = (*FakeStringer)(nil)")
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	generate := func() []byte {
+		g, err := parsePackage("testdata/embedder", []string{"testdata/embedder/embedder_def.go"})
+		if err != nil {
+			t.Fatalf("parsePackage error: %s", err)
+		}
+		src, err := g.Generate([]string{"Embedder"})
+		if err != nil {
+			t.Fatalf("Generator.Generate error: %s", err)
+		}
+		again, err := g.Generate([]string{"Embedder"})
+		if err != nil {
+			t.Fatalf("Generator.Generate error: %s", err)
+		}
+		assert.Equal(t, string(src), string(again))
+
+		return src
+	}
+
+	assert.Equal(t, string(generate()), string(generate()))
+}
@@ -3,12 +3,10 @@ module github.com/percolate/charlatan
 go 1.19
 
 require (
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/sergi/go-diff v0.0.0-20170409071739-feef008d51ad
 	github.com/stretchr/testify v1.1.4
 	golang.org/x/tools v0.0.0-20171116013056-6d70fb2e8532
 )
 
-require (
-	github.com/davecgh/go-spew v1.1.0 // indirect
-	github.com/pmezard/go-difflib v1.0.0 // indirect
-)
+require github.com/davecgh/go-spew v1.1.0 // indirect
@@ -14,7 +14,9 @@ import (
 var (
 	golden = []string{
 		"Array",
+		"Cassetter",
 		"Channeler",
+		"Documenter",
 		"Embedder",
 		"Funcer",
 		"Identifier",
@@ -71,9 +73,6 @@ func CheckOneGolden(t *testing.T) {
 	name := path.Base(t.Name())
 	lname := strings.ToLower(name)
 
-	symGen.reset()
-	identSymGen.reset()
-
 	inputFilename := fmt.Sprintf("./testdata/%s/%s_def.go", lname, lname)
 	outputFilename := fmt.Sprintf("./testdata/%s/%s.go", lname, lname)
 
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/build/constraint"
+	"go/scanner"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// commandLineExcluded are the flags that do not affect the output
+var commandLineExcluded = map[string]bool{"check": true, "diff": true}
+
+// commandLinePaths are the flags whose values are file paths, recorded relative to the working directory
+var commandLinePaths = map[string]bool{
+	"config":         true,
+	"dir":            true,
+	"from-recording": true,
+	"header-file":    true,
+	"output":         true,
+	"output-dir":     true,
+	"stdin-filename": true,
+	"template":       true,
+	"template-dir":   true,
+}
+
+// CommandLine returns a reproducible form of the command line parsed by the given flag set.  The flags are sorted
+// by name, and absolute paths are made relative to the working directory wd.
+func CommandLine(flags *flag.FlagSet, wd string) string {
+	argv := []string{"charlatan"}
+	flags.Visit(func(f *flag.Flag) {
+		if commandLineExcluded[f.Name] {
+			return
+		}
+		value := f.Value.String()
+		if commandLinePaths[f.Name] && value != "-" {
+			value = relativePath(wd, value)
+		}
+		argv = append(argv, fmt.Sprintf("-%s=%s", f.Name, shellQuote(value)))
+	})
+	for _, arg := range flags.Args() {
+		argv = append(argv, shellQuote(arg))
+	}
+
+	return strings.Join(argv, " ")
+}
+
+// relativePath returns path relative to the directory wd if it is absolute, with forward slashes
+func relativePath(wd, path string) string {
+	if filepath.IsAbs(path) && wd != "" {
+		if rel, err := filepath.Rel(wd, path); err == nil {
+			path = rel
+		}
+	}
+
+	return filepath.ToSlash(path)
+}
+
+// shellQuote quotes the value for a POSIX shell if it contains anything but safe characters
+func shellQuote(value string) string {
+	safe := value != ""
+	for _, r := range value {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./,:=+{}%@", r)) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return value
+	}
+
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// LoadHeader reads the text prepended to the output from a file.  Text that is not already made of Go comments is
+// turned into line comments.
+func LoadHeader(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("cannot load header: %s", err)
+	}
+	text := strings.TrimRight(strings.ReplaceAll(string(data), "\r\n", "\n"), " \t\n")
+	if text == "" {
+		return "", nil
+	}
+
+	if isComment(text) {
+		return text, nil
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("// "+line, " ")
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// isComment returns true if the text consists only of Go comments
+func isComment(text string) bool {
+	src := []byte(text)
+	var s scanner.Scanner
+	var errors int
+	s.Init(token.NewFileSet().AddFile("", -1, len(src)), src, func(token.Position, string) { errors++ }, scanner.ScanComments)
+	for {
+		_, tok, _ := s.Scan()
+		switch {
+		case errors > 0:
+			return false
+		case tok == token.EOF:
+			return true
+		case tok != token.COMMENT:
+			return false
+		}
+	}
+}
+
+// ParseBuildTags parses a build constraint expression, e.g. "integration && !race".  A comma separated list of
+// tags, each optionally negated with a leading "!", requires all of them.
+func ParseBuildTags(tags string) (string, error) {
+	tags = strings.TrimSpace(tags)
+	if tags == "" {
+		return "", nil
+	}
+	if terms := strings.Split(tags, ","); len(terms) > 1 && commaTerms(terms) {
+		tags = strings.Join(terms, " && ")
+	}
+
+	expr, err := constraint.Parse("//go:build " + tags)
+	if err != nil {
+		return "", fmt.Errorf("error: invalid build tags %q: %s", tags, err)
+	}
+
+	return expr.String(), nil
+}
+
+// commaTerms reports whether each term of a comma separated list is a tag or a negated tag, trimming the terms
+func commaTerms(terms []string) bool {
+	for i, term := range terms {
+		terms[i] = strings.TrimSpace(term)
+		if strings.ContainsAny(strings.TrimPrefix(terms[i], "!"), "&|!() ") {
+			return false
+		}
+	}
+
+	return true
+}
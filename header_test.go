@@ -0,0 +1,118 @@
+package main
+
+import (
+	"flag"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandLine(t *testing.T) {
+	flags := flag.NewFlagSet("charlatan", flag.ContinueOnError)
+	flags.String("output", "", "")
+	flags.String("dir", "", "")
+	flags.String("build-tags", "", "")
+	flags.Bool("check", false, "")
+	flags.String("package", "", "")
+
+	wd := filepath.FromSlash("/home/user/project")
+	err := flags.Parse([]string{
+		"-output", filepath.Join(wd, "fakes", "charlatan.go"),
+		"-check",
+		"-build-tags", "integration && !race",
+		"-dir", ".",
+		"Service", "Store",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "charlatan -build-tags='integration && !race' -dir=. -output=fakes/charlatan.go Service Store", CommandLine(flags, wd))
+}
+
+func TestLoadHeader(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	header, err := LoadHeader(write("plain.txt", "Copyright Example Corp.\r\n\r\nAll rights reserved.\n\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "// Copyright Example Corp.\n//\n// All rights reserved.", header)
+
+	header, err = LoadHeader(write("line.txt", "// Copyright Example Corp.\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "// Copyright Example Corp.", header)
+
+	header, err = LoadHeader(write("block.txt", "/*\n * Copyright Example Corp.\n */\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "/*\n * Copyright Example Corp.\n */", header)
+
+	_, err = LoadHeader(filepath.Join(dir, "missing.txt"))
+	assert.NotNil(t, err)
+}
+
+func TestParseBuildTags(t *testing.T) {
+	tags, err := ParseBuildTags("")
+	assert.Nil(t, err)
+	assert.Equal(t, "", tags)
+
+	tags, err = ParseBuildTags("integration,linux")
+	assert.Nil(t, err)
+	assert.Equal(t, "integration && linux", tags)
+
+	tags, err = ParseBuildTags("integration, !race")
+	assert.Nil(t, err)
+	assert.Equal(t, "integration && !race", tags)
+
+	tags, err = ParseBuildTags("integration&&(linux||darwin)")
+	assert.Nil(t, err)
+	assert.Equal(t, "integration && (linux || darwin)", tags)
+
+	_, err = ParseBuildTags("integration &&")
+	assert.EqualError(t, err, `error: invalid build tags "integration &&": unexpected end of expression`)
+}
+
+func TestGenerateHeader(t *testing.T) {
+	g, err := parsePackage("testdata/voider", []string{"testdata/voider/voider_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	g.Header = "// Copyright Example Corp."
+	g.BuildTags = "integration"
+
+	src, err := g.Generate([]string{"Voider"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+	assert.True(t, strings.HasPrefix(string(src), `// Copyright Example Corp.
+
+// Code generated by "charlatan Voider".  DO NOT EDIT.
+
+//go:build integration
+
+package main
+`), string(src))
+
+	file, err := parser.ParseFile(token.NewFileSet(), "voider.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+	assert.True(t, isGenerated(file))
+
+	g.CommandLine = "charlatan -output=voider.go Voider"
+	src, err = g.Generate([]string{"Voider"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+	assert.Contains(t, string(src), `// Code generated by "charlatan -output=voider.go Voider".  DO NOT EDIT.`)
+}
@@ -2,13 +2,18 @@ package main
 
 import (
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"strings"
+	"unicode"
 )
 
 // Identifier is a declared identifier
 type Identifier struct {
-	Name            string
-	ValueType       Type
+	Name            string // the identifier's name, generated if not declared
+	ValueType       Type   // the identifier's type
 	titleCase       string
 	parameterFormat string
 	referenceFormat string
@@ -59,3 +64,146 @@ func (i *Identifier) Signature() string {
 
 	return i.signature
 }
+
+// String returns the identifier's name
+func (i *Identifier) String() string {
+	return i.Name
+}
+
+// reservedNames are used by the generated code in the scope of a method's parameters and results
+var reservedNames = map[string]bool{
+	"f":          true,
+	"t":          true,
+	"invocation": true,
+	"reflect":    true,
+}
+
+// nameIdentifiers names the unnamed parameters and results of a method after their types, e.g. "ctx" for a
+// context.Context or "things" for a []*Thing.  Names are numbered when they would collide, and types without a
+// readable name fall back to "ident1", "ident2", etc.
+func nameIdentifiers(parameters, results []*Identifier) {
+	idents := append(append([]*Identifier{}, parameters...), results...)
+
+	// N.B. - names must not shadow the identifiers used by the types in the signature
+	shadowed := make(map[string]bool)
+	for _, ident := range idents {
+		if expr, err := parser.ParseExpr(ident.ValueType.FieldFormat()); err == nil {
+			ast.Inspect(expr, func(node ast.Node) bool {
+				if id, ok := node.(*ast.Ident); ok {
+					shadowed[id.Name] = true
+				}
+				return true
+			})
+		}
+	}
+	// N.B. - names are compared in title case since they also name the fields of the invocation types
+	taken := make(map[string]bool)
+	for _, ident := range idents {
+		if !isUnnamed(ident) {
+			taken[ident.TitleCase()] = true
+		}
+	}
+	available := func(name string) bool {
+		return !reservedNames[name] && !shadowed[name] && !token.Lookup(name).IsKeyword() &&
+			types.Universe.Lookup(name) == nil && !taken[strings.Title(name)]
+	}
+
+	bases := make([]string, len(idents))
+	counts := make(map[string]int)
+	for i, ident := range idents {
+		if isUnnamed(ident) {
+			bases[i] = typeName(ident.ValueType)
+			counts[bases[i]]++
+		}
+	}
+
+	symbols := &symbolGenerator{Prefix: "ident"}
+	for i, ident := range idents {
+		if !isUnnamed(ident) {
+			continue
+		}
+
+		name := bases[i]
+		switch {
+		case name == "":
+			for name = symbols.next(); !available(name); name = symbols.next() {
+			}
+		case counts[name] > 1 || !available(name):
+			numbered := &symbolGenerator{Prefix: name}
+			for name = numbered.next(); !available(name); name = numbered.next() {
+			}
+		}
+		taken[strings.Title(name)] = true
+		ident.Name = name
+	}
+}
+
+func isUnnamed(ident *Identifier) bool {
+	return ident.Name == "" || ident.Name == "_"
+}
+
+// typeName returns a readable variable name for a value of the given type, or "" if there is none
+func typeName(t Type) string {
+	switch actual := t.(type) {
+	case *BasicType:
+		switch {
+		case actual.Path == "context" && actual.Name == "Context":
+			return "ctx"
+		case actual.Qualifier == "" && actual.Name == "error":
+			return "err"
+		case !token.IsIdentifier(actual.Name):
+			// N.B. - type literal
+			return ""
+		case actual.Qualifier == "" && types.Universe.Lookup(actual.Name) != nil:
+			return ""
+		}
+		return lowerCamelCase(actual.Name)
+	case *Pointer:
+		return typeName(actual.subType)
+	case *Array:
+		return pluralName(actual.subType)
+	case *Ellipsis:
+		return pluralName(actual.subType)
+	}
+
+	return ""
+}
+
+// pluralName returns the plural of the readable name of the element type, or "" if there is none
+func pluralName(elem Type) string {
+	switch elem.(type) {
+	case *Array, *Ellipsis:
+		return ""
+	}
+
+	name := typeName(elem)
+	switch {
+	case name == "":
+		return ""
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"), strings.HasSuffix(name, "z"),
+		strings.HasSuffix(name, "ch"), strings.HasSuffix(name, "sh"):
+		return name + "es"
+	case len(name) > 1 && strings.HasSuffix(name, "y") && !strings.ContainsRune("aeiou", rune(name[len(name)-2])):
+		return name[:len(name)-1] + "ies"
+	}
+
+	return name + "s"
+}
+
+// lowerCamelCase lower cases the leading upper case letters of the name, keeping the last one of an initialism
+// followed by a word, e.g. "HTTPClient" becomes "httpClient" and "ID" becomes "id"
+func lowerCamelCase(name string) string {
+	runes := []rune(name)
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+	if upper > 1 && upper < len(runes) {
+		upper--
+	}
+	for i := 0; i < upper; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	return string(runes)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameIdentifiers(t *testing.T) {
+	thing := &BasicType{Name: "Thing"}
+	context := &BasicType{Name: "Context", Qualifier: "context", Path: "context"}
+	errorType := &BasicType{Name: "error"}
+
+	tests := []struct {
+		name       string
+		parameters []*Identifier
+		results    []*Identifier
+		expected   []string
+	}{
+		{
+			name:       "derived",
+			parameters: []*Identifier{{ValueType: context}, {ValueType: &Pointer{subType: thing}}},
+			results:    []*Identifier{{ValueType: &Array{subType: &Pointer{subType: thing}}}, {ValueType: errorType}},
+			expected:   []string{"ctx", "thing", "things", "err"},
+		},
+		{
+			name:       "collision",
+			parameters: []*Identifier{{ValueType: thing}, {ValueType: thing}},
+			results:    []*Identifier{{Name: "err", ValueType: errorType}, {ValueType: errorType}},
+			expected:   []string{"thing1", "thing2", "err", "err1"},
+		},
+		{
+			name:       "fallback",
+			parameters: []*Identifier{{ValueType: &BasicType{Name: "string"}}, {Name: "ident1", ValueType: thing}},
+			results:    []*Identifier{{ValueType: &Map{keyType: thing, subType: thing}}},
+			expected:   []string{"ident2", "ident1", "ident3"},
+		},
+		{
+			name:       "reserved",
+			parameters: []*Identifier{{ValueType: &BasicType{Name: "Invocation"}}, {ValueType: &BasicType{Name: "Type"}}},
+			results:    []*Identifier{{ValueType: &BasicType{Name: "T", Qualifier: "testing"}}},
+			expected:   []string{"invocation1", "type1", "t1"},
+		},
+		{
+			name:       "shadowed",
+			parameters: []*Identifier{{ValueType: &BasicType{Name: "thing"}}, {ValueType: &BasicType{Name: "URL", Qualifier: "url"}}},
+			expected:   []string{"thing1", "url1"},
+		},
+		{
+			name:       "initialism",
+			parameters: []*Identifier{{ValueType: &BasicType{Name: "HTTPClient"}}, {ValueType: &Ellipsis{subType: &BasicType{Name: "Entry"}}}},
+			expected:   []string{"httpClient", "entries"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nameIdentifiers(test.parameters, test.results)
+
+			var names []string
+			for _, ident := range append(test.parameters, test.results...) {
+				names = append(names, ident.Name)
+			}
+			assert.Equal(t, test.expected, names)
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package main
 
+import "strconv"
+
 // Import represents a declared import
 type Import struct {
 	Name     string // the package's name
@@ -52,3 +54,35 @@ func (r *ImportSet) RequireByName(s string) {
 		}
 	}
 }
+
+// PathByName returns the unquoted import path for an import symbol, or the empty string if not found
+func (r *ImportSet) PathByName(s string) string {
+	for _, imp := range r.imports {
+		if imp.Name == s || imp.Alias == s {
+			path, err := strconv.Unquote(imp.Path)
+			if err != nil {
+				return imp.Path
+			}
+			return path
+		}
+	}
+
+	return ""
+}
+
+// QualifierByName returns the qualifier used in source for an import symbol, the empty string for a dot import
+func (r *ImportSet) QualifierByName(s string) string {
+	for _, imp := range r.imports {
+		if imp.Name == s || imp.Alias == s {
+			if imp.Alias == "." {
+				return ""
+			}
+			if imp.Alias != "" {
+				return imp.Alias
+			}
+			return imp.Name
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,15 @@
+//go:build go1.12
+// +build go1.12
+
+package main
+
+import (
+	"go/importer"
+	"go/token"
+	"go/types"
+)
+
+// defaultImporter shares the fileset so that positions of imported declarations can be resolved
+func defaultImporter(fileset *token.FileSet) types.Importer {
+	return importer.ForCompiler(fileset, "source", nil)
+}
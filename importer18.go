@@ -9,9 +9,10 @@ package main
 
 import (
 	"go/importer"
+	"go/token"
 	"go/types"
 )
 
-func defaultImporter() types.Importer {
+func defaultImporter(fileset *token.FileSet) types.Importer {
 	return importer.Default()
 }
@@ -2,16 +2,17 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build go1.9
-// +build go1.9
+//go:build go1.9 && !go1.12
+// +build go1.9,!go1.12
 
 package main
 
 import (
 	"go/importer"
+	"go/token"
 	"go/types"
 )
 
-func defaultImporter() types.Importer {
+func defaultImporter(fileset *token.FileSet) types.Importer {
 	return importer.For("source", nil)
 }
@@ -3,18 +3,76 @@ package main
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
 )
 
-var (
-	identSymGen = symbolGenerator{Prefix: "ident"}
-)
-
 // Interface represents a declared interface.
 type Interface struct {
-	Name    string
-	Methods []*Method
-	embeds  []string
+	Name       string    // the interface's name
+	Methods    []*Method // the method set, including embedded methods
+	embeds     []string
+	pos        token.Pos // start of the declaration, if parsed from source
+	end        token.Pos // end of the declaration, if parsed from source
+	err        error     // error preventing generation, reported only if requested
+	naming     *namer
+	importName string           // the name of the package declaring an imported interface
+	qualifier  string           // the package qualifier of the interface in the output source, if any
+	typ        *types.Interface // the type checked interface, if any
+}
+
+// FakeName returns the name of the fake type
+func (i *Interface) FakeName() string {
+	return i.naming.fakeName(i.Name)
+}
+
+// QualifiedName returns the name of the interface as referenced from the output source, e.g. "fmt.Stringer"
+func (i *Interface) QualifiedName() string {
+	if i.qualifier == "" {
+		return i.Name
+	}
+
+	return i.qualifier + "." + i.Name
+}
+
+// ReturnsError returns true if any method of the interface returns an error as its last result
+func (i *Interface) ReturnsError() bool {
+	for _, m := range i.Methods {
+		if m.ReturnsError() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// annotateMethods records the underlying types and packages of the named types of the methods parsed from source,
+// which are only known once the local package is type checked
+func (i *Interface) annotateMethods(local *types.Package) {
+	for _, m := range i.Methods {
+		for j := 0; j < i.typ.NumMethods(); j++ {
+			f := i.typ.Method(j)
+			if f.Name() != m.Name {
+				continue
+			}
+			signature := f.Type().(*types.Signature)
+			if signature.Params().Len() == len(m.Parameters) {
+				for k, ident := range m.Parameters {
+					annotateType(ident.ValueType, signature.Params().At(k).Type(), local)
+				}
+			}
+			if signature.Results().Len() == len(m.Results) {
+				for k, ident := range m.Results {
+					annotateType(ident.ValueType, signature.Results().At(k).Type(), local)
+				}
+			}
+		}
+	}
+}
+
+// ConstructorName returns the name of the fake constructor for the given variant, e.g. "DefaultPanic"
+func (i *Interface) ConstructorName(variant string) string {
+	return i.naming.constructorName(i.Name, variant)
 }
 
 func (i *Interface) addMethodFromField(field *ast.Field, imports *ImportSet) error {
@@ -26,9 +84,10 @@ func (i *Interface) addMethodFromField(field *ast.Field, imports *ImportSet) err
 	method := &Method{
 		Interface: i.Name,
 		Name:      field.Names[0].Name,
+		Doc:       field.Doc.Text(),
+		pos:       field.Pos(),
 	}
 
-	identSymGen.reset()
 	// `Params.List` can be 0-length, but `Results` can be nil
 	for _, parameter := range functionType.Params.List {
 		identifiers, err := extractIdentifiersFromField(parameter, imports)
@@ -47,6 +106,7 @@ func (i *Interface) addMethodFromField(field *ast.Field, imports *ImportSet) err
 			method.Results = append(method.Results, identifiers...)
 		}
 	}
+	nameIdentifiers(method.Parameters, method.Results)
 
 	i.Methods = append(i.Methods, method)
 	return nil
@@ -56,6 +116,7 @@ func (i *Interface) addMethodFromType(f *types.Func, imports *ImportSet) error {
 	method := &Method{
 		Interface: i.Name,
 		Name:      f.Name(),
+		pos:       f.Pos(),
 	}
 
 	sig := f.Type().(*types.Signature)
@@ -78,6 +139,7 @@ func (i *Interface) addMethodFromType(f *types.Func, imports *ImportSet) error {
 		return err
 	}
 	method.Results = append(method.Results, results...)
+	nameIdentifiers(method.Parameters, method.Results)
 
 	i.Methods = append(i.Methods, method)
 
@@ -93,7 +155,6 @@ func extractIdentifiersFromField(field *ast.Field, imports *ImportSet) ([]*Ident
 	if len(field.Names) == 0 {
 		return []*Identifier{
 			{
-				Name:      identSymGen.next(),
 				ValueType: identifierType,
 			},
 		}, nil
@@ -122,14 +183,10 @@ func extractIdentifiersFromTuple(tuple *types.Tuple, imports *ImportSet) ([]*Ide
 		if err != nil {
 			return nil, err
 		}
-		ident := &Identifier{
+		idents[i] = &Identifier{
 			Name:      p.Name(),
 			ValueType: identifierType,
 		}
-		if "" == ident.Name {
-			ident.Name = identSymGen.next()
-		}
-		idents[i] = ident
 	}
 
 	return idents, nil
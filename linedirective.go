@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// AddLineDirectives annotates the methods of the fakes in the generated source with a //line directive pointing
+// at the interface method they implement, followed by a directive restoring the position in the output file at
+// the given path.  Methods of interfaces declared outside of the package are not annotated.
+func (g *Generator) AddLineDirectives(interfaceNames []string, path string, src []byte) ([]byte, error) {
+	decls, err := g.resolve(interfaceNames)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+
+	local := make(map[*token.File]bool, len(g.files))
+	for _, file := range g.files {
+		local[g.fileset.File(file.Pos())] = true
+	}
+
+	methods := make(map[string]map[string]*Method, len(decls))
+	for _, decl := range decls {
+		methods[decl.FakeName()] = make(map[string]*Method, len(decl.Methods))
+		for _, m := range decl.Methods {
+			if m.pos.IsValid() && local[g.fileset.File(m.pos)] {
+				methods[decl.FakeName()][m.Name] = m
+			}
+		}
+	}
+
+	fileset := token.NewFileSet()
+	file, err := parser.ParseFile(fileset, path, src, 0)
+	if err != nil {
+		return nil, fmt.Errorf("internal error: invalid code generated: %s", err)
+	}
+
+	// N.B. - directives are keyed by the line they precede or follow in the generated source
+	before := make(map[int]string)
+	after := make(map[int]bool)
+	for _, node := range file.Decls {
+		fn, ok := node.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+			continue
+		}
+		m := methods[receiverName(fn.Recv.List[0].Type)][fn.Name.Name]
+		if m == nil {
+			continue
+		}
+
+		position := g.fileset.Position(m.pos)
+		filename, err := filepath.Abs(position.Filename)
+		if err != nil {
+			return nil, err
+		}
+		if rel, err := filepath.Rel(dir, filename); err == nil {
+			filename = rel
+		}
+		before[fileset.Position(fn.Pos()).Line] = fmt.Sprintf("//line %s:%d:%d", filepath.ToSlash(filename), position.Line, position.Column)
+		after[fileset.Position(fn.End()).Line] = true
+	}
+
+	var out bytes.Buffer
+	line := 0
+	for i, text := range strings.SplitAfter(string(src), "\n") {
+		if directive, ok := before[i+1]; ok {
+			out.WriteString(directive + "\n")
+			line++
+		}
+		out.WriteString(text)
+		line++
+		if after[i+1] {
+			out.WriteString(fmt.Sprintf("//line %s:%d:1\n", filepath.Base(path), line+2))
+			line++
+		}
+	}
+
+	return out.Bytes(), nil
+}
+
+// receiverName returns the name of the type of a method receiver
+func receiverName(recv ast.Expr) string {
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if ident, ok := recv.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
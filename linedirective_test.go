@@ -0,0 +1,53 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddLineDirectives(t *testing.T) {
+	g, err := parsePackage("testdata/embedder", []string{"testdata/embedder/embedder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	names := []string{"Embedder"}
+
+	src, err := g.Generate(names)
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+	src, err = g.AddLineDirectives(names, "testdata/embedder/fakes/charlatan.go", src)
+	if err != nil {
+		t.Fatalf("Generator.AddLineDirectives error: %s", err)
+	}
+
+	fileset := token.NewFileSet()
+	file, err := parser.ParseFile(fileset, "testdata/embedder/fakes/charlatan.go", src, 0)
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+
+	positions := make(map[string]token.Position)
+	lines := make(map[string]int)
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil {
+			positions[fn.Name.Name] = fileset.Position(fn.Pos())
+			lines[fn.Name.Name] = fileset.PositionFor(fn.Pos(), false).Line
+		}
+	}
+
+	assert.Equal(t, "testdata/embedder/embedder_def.go", positions["Other"].Filename)
+	assert.Equal(t, 10, positions["Other"].Line)
+	assert.Equal(t, "testdata/embedder/embedder_def.go", positions["Embed"].Filename)
+	assert.Equal(t, 14, positions["Embed"].Line)
+
+	// N.B. - methods of imported interfaces and helpers keep their positions in the generated file
+	for _, name := range []string{"String", "SetOtherStub", "Reset"} {
+		assert.Equal(t, "testdata/embedder/fakes/charlatan.go", positions[name].Filename, name)
+		assert.Equal(t, lines[name], positions[name].Line, name)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -38,15 +39,44 @@ Options:
 )
 
 var (
-	outputPath    = flag.String("output", "", "output file path [default: ./charlatan.go]")
+	outputPath    = flag.String("output", "", "output file path, or - for standard output [default: ./charlatan.go]")
+	readStdin     = flag.Bool("stdin", false, "read a Go source file of the input package from standard input instead of disk")
+	stdinFilename = flag.String("stdin-filename", "", "file name of the source read by -stdin, replacing that file of the input package, required by -stdin")
+	outputDir     = flag.String("output-dir", "", "output directory, one file is written per interface")
+	filenamePat   = flag.String("filename-pattern", "fake_{{.Name | lower}}.go", "template for the file names written to -output-dir")
 	outputPackage = flag.String("package", "", "output package name [default: \"<current package>\"]")
 	dirName       = flag.String("dir", "", "input package directory [default: current package directory]")
+	templatePath  = flag.String("template", "", "template file used to generate the output [default: built-in template]")
+	templateDir   = flag.String("template-dir", "", "directory of partial templates (*.tmpl) available to -template")
+	bestEffort    = flag.Bool("best-effort", false, "generate the interfaces that type check cleanly even if the package has errors")
+	configPath    = flag.String("config", "", "JSON configuration file, flags take precedence")
+	naming        Naming
+	checkOutput   = flag.Bool("check", false, "compare the generated source with the existing output, exit with an error and print a diff if it is stale")
+	diffOutputs   = flag.Bool("diff", false, "print a diff of the generated source against the existing output instead of writing it")
+	emitModel     = flag.Bool("emit-model", false, "write the interface model as JSON to standard output instead of generating source")
+	pluginCommand = flag.String("plugin", "", "command that reads the JSON interface model on standard input and returns the files to write")
+	featureList   = flag.String("features", "", "comma separated features to generate, all or one or more of: "+FeatureNames()+" [default: all but sequences,matchers,order,sync,strict,cassettes]")
+	noFeatureList = flag.String("no-features", "", "comma separated features not to generate")
+	lineDirective = flag.Bool("line-directives", false, "annotate the methods of the fakes with //line directives pointing at the interface methods")
+	buildTags     = flag.String("build-tags", "", "build constraint written as a //go:build line of the output, e.g. \"integration && !race\"")
+	headerPath    = flag.String("header-file", "", "file containing a comment, e.g. a license, prepended to the output")
+	testingType   = flag.String("testing-t", "", "type of the testing parameters, one of: interface (one per fake), shared (one per package), tb (testing.TB) [default: interface]")
+	selfTest      = flag.Bool("self-test", false, "also write a _test.go file exercising the default constructors and Reset of each fake")
+	recordingPath = flag.String("from-recording", "", "cassette written by NewFake*Record, write test source replaying and asserting its calls [default output: ./<cassette>_recording_test.go]")
 )
 
 func init() {
 	log.SetFlags(0)
 	log.SetPrefix("charlatan: ")
 	flag.Usage = usage
+
+	flag.StringVar(&naming.Fake, "fake-name", "", "naming pattern for the fake types [default: "+defaultNaming.Fake+"]")
+	flag.StringVar(&naming.Constructor, "constructor-name", "", "naming pattern for the fake constructors [default: "+defaultNaming.Constructor+"]")
+	flag.StringVar(&naming.Invocation, "invocation-name", "", "naming pattern for the invocation types [default: "+defaultNaming.Invocation+"]")
+	flag.StringVar(&naming.InvocationConstructor, "invocation-constructor-name", "", "naming pattern for the invocation constructors [default: "+defaultNaming.InvocationConstructor+"]")
+	flag.StringVar(&naming.Hook, "hook-name", "", "naming pattern for the hook fields [default: "+defaultNaming.Hook+"]")
+	flag.StringVar(&naming.Calls, "calls-name", "", "naming pattern for the calls fields [default: "+defaultNaming.Calls+"]")
+	flag.StringVar(&naming.Results, "results-name", "", "naming pattern for the results types of the stub sequences [default: "+defaultNaming.Results+"]")
 }
 
 func usage() {
@@ -63,8 +93,50 @@ func main() {
 		os.Exit(1)
 	}
 
-	if *outputPath != "" && !strings.HasSuffix(*outputPath, ".go") {
-		log.Print("output path must be a Go source file name")
+	if *outputPath != "" && *outputPath != "-" && !strings.HasSuffix(*outputPath, ".go") {
+		log.Print("output path must be a Go source file name or -")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if *outputPath != "" && *outputDir != "" {
+		log.Print("output path and output directory are mutually exclusive")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if *outputPath == "-" && (*checkOutput || *diffOutputs) {
+		log.Print("output path - cannot be checked")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if *lineDirective && (*outputPath == "-" || *pluginCommand != "") {
+		log.Print("line directives require an output file or directory")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if *selfTest && (*outputPath == "-" || *pluginCommand != "") {
+		log.Print("self test requires an output file or directory")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if *recordingPath != "" && (*outputDir != "" || *pluginCommand != "" || *selfTest || *lineDirective || *emitModel) {
+		log.Print("from recording is incompatible with output directory, plugin, self test, line directives and emit model")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if *readStdin != (*stdinFilename != "") {
+		log.Print("standard input and standard input file name require each other")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if *templateDir != "" && *templatePath == "" {
+		log.Print("template directory requires a template file")
 		flag.Usage()
 		os.Exit(1)
 	}
@@ -74,36 +146,215 @@ func main() {
 		packageDirectory = *dirName
 	}
 
-	g, err := LoadPackageDir(packageDirectory)
+	var g *Generator
+	var err error
+	if *readStdin {
+		src, readErr := ioutil.ReadAll(os.Stdin)
+		if readErr != nil {
+			log.Fatalf("error reading standard input: %s", readErr)
+		}
+		g, err = LoadPackageSource(packageDirectory, *stdinFilename, src)
+	} else {
+		g, err = LoadPackageDir(packageDirectory)
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	g.PackageOverride = *outputPackage
+	if *configPath != "" {
+		config, err := LoadConfig(*configPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		g.Naming = config.Naming
+	}
 
-	src, err := g.Generate(flag.Args())
+	g.PackageOverride = *outputPackage
+	g.Naming = g.Naming.Merge(naming)
+	g.BestEffort = *bestEffort
+	g.SelfTest = *selfTest
+	g.Features, err = ParseFeatures(*featureList, *noFeatureList)
 	if err != nil {
-		log.Print(err)
+		log.Fatal(err)
 	}
-	if src == nil {
-		os.Exit(1)
+	g.TestingT, err = ParseTestingT(*testingType)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g.BuildTags, err = ParseBuildTags(*buildTags)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *headerPath != "" {
+		g.Header, err = LoadHeader(*headerPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	wd, _ := os.Getwd()
+	g.CommandLine = CommandLine(flag.CommandLine, wd)
+
+	if *templatePath != "" {
+		g.Template, err = LoadTemplate(*templatePath, *templateDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if *emitModel {
+		model, err := g.Model(flag.Args())
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Stdout.Write(append(model, '\n'))
+		return
+	}
+
+	var outputs []*outputFile
+	switch {
+	case *pluginCommand != "":
+		model, err := g.Model(flag.Args())
+		if err != nil {
+			log.Fatal(err)
+		}
+		files, err := RunPlugin(*pluginCommand, model)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, file := range files {
+			outputs = append(outputs, &outputFile{filepath.Join(*outputDir, file.Name), []byte(file.Content)})
+		}
+	case *recordingPath != "":
+		if *outputPath == "" {
+			base := filepath.Base(*recordingPath)
+			*outputPath = strings.TrimSuffix(base, filepath.Ext(base)) + "_recording_test.go"
+		}
+		src, err := g.GenerateRecording(flag.Args(), *recordingPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		outputs = append(outputs, &outputFile{*outputPath, src})
+	case *outputDir != "":
+		g.Output = *outputDir
+		files, err := g.GenerateFiles(flag.Args(), *filenamePat)
+		if err != nil {
+			log.Fatal(err)
+		}
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			outputs = append(outputs, &outputFile{filepath.Join(*outputDir, name), files[name]})
+		}
+	default:
+		if *outputPath == "" {
+			*outputPath = "charlatan.go"
+		}
+		if *outputPath != "-" {
+			g.Output = *outputPath
+		}
+
+		src, err := g.Generate(flag.Args())
+		if err != nil {
+			log.Fatal(err)
+		}
+		outputs = append(outputs, &outputFile{*outputPath, src})
+
+		if *selfTest {
+			test, err := g.GenerateTest(flag.Args())
+			if err != nil {
+				log.Fatal(err)
+			}
+			outputs = append(outputs, &outputFile{strings.TrimSuffix(*outputPath, ".go") + "_test.go", test})
+		}
+	}
+
+	if *lineDirective {
+		for _, output := range outputs {
+			output.src, err = g.AddLineDirectives(flag.Args(), output.path, output.src)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
 	}
 
-	if *outputPath == "" {
-		*outputPath = "charlatan.go"
+	// N.B. - plugins may produce files that are not Go source
+	if *pluginCommand == "" {
+		sources := make(map[string][]byte, len(outputs))
+		for _, output := range outputs {
+			sources[output.path] = output.src
+		}
+		if err := g.Validate(flag.Args(), sources); err != nil {
+			log.Fatal(err)
+		}
 	}
 
-	if err := os.MkdirAll(filepath.Dir(*outputPath), 0755); err != nil {
+	if *checkOutput || *diffOutputs {
+		stale := false
+		for _, output := range outputs {
+			diff, err := diffOutput(output.path, output.src)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if diff != "" {
+				stale = true
+				fmt.Print(diff)
+			}
+		}
+		if stale && *checkOutput {
+			log.Fatal("generated output is stale, run charlatan to update it")
+		}
+		return
+	}
+
+	for _, output := range outputs {
+		writeOutput(output.path, output.src)
+	}
+}
+
+type outputFile struct {
+	path string
+	src  []byte
+}
+
+// writeOutput writes through a temporary file that is renamed to the path, to never leave a partially written file
+func writeOutput(path string, src []byte) {
+	if path == "-" {
+		if _, err := os.Stdout.Write(src); err != nil {
+			log.Fatalf("error writing output: %s", err)
+		}
+		return
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
 		log.Fatalf("error writing output: %s", err)
 	}
 
-	if err := ioutil.WriteFile(*outputPath, src, 0644); err != nil {
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(path)+".")
+	if err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+	_, err = tmp.Write(src)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
 		log.Fatalf("error writing output: %s", err)
 	}
 
-	out, err := filepath.Abs(*outputPath)
+	out, err := filepath.Abs(path)
 	if err != nil {
-		out = *outputPath
+		out = path
 	}
 	log.Printf("wrote %s\n", out)
 }
@@ -1,13 +1,19 @@
 package main
 
-import "strings"
+import (
+	"go/token"
+	"strings"
+)
 
 // Method represents a method in an interface's method set
 type Method struct {
-	Interface             string
-	Name                  string
-	Parameters            []*Identifier
-	Results               []*Identifier
+	Interface             string        // the name of the interface being faked
+	Name                  string        // the method's name
+	Parameters            []*Identifier // the method's parameters
+	Results               []*Identifier // the method's results
+	Doc                   string        // the method's doc comment text, if any
+	pos                   token.Pos
+	naming                *namer
 	parametersDeclaration string
 	resultsDeclaration    string
 	parametersCall        string
@@ -16,6 +22,66 @@ type Method struct {
 	resultsSignature      string
 }
 
+// DocComment returns the method's doc comment as comment lines, each ending with a newline
+func (m *Method) DocComment() string {
+	return commentLines(m.Doc)
+}
+
+// Deprecated returns the deprecation notice of the method, the paragraph of its doc comment starting with "Deprecated: "
+func (m *Method) Deprecated() string {
+	for _, paragraph := range strings.Split(m.Doc, "\n\n") {
+		if strings.HasPrefix(paragraph, "Deprecated: ") {
+			return strings.TrimSpace(paragraph)
+		}
+	}
+
+	return ""
+}
+
+// DeprecatedComment returns the deprecation notice of the method as a comment paragraph to append to a doc comment
+func (m *Method) DeprecatedComment() string {
+	notice := m.Deprecated()
+	if notice == "" {
+		return ""
+	}
+
+	return "//\n" + commentLines(notice)
+}
+
+// ZeroArguments returns the zero values of the method's parameters as call arguments, a variadic parameter is omitted
+func (m *Method) ZeroArguments() string {
+	values := make([]string, 0, len(m.Parameters))
+	for _, ident := range m.Parameters {
+		if _, variadic := ident.ValueType.(*Ellipsis); variadic {
+			continue
+		}
+		values = append(values, zeroValue(ident.ValueType))
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// ReturnsError returns true if the method's last result is an error
+func (m *Method) ReturnsError() bool {
+	if len(m.Results) == 0 {
+		return false
+	}
+	last, ok := m.Results[len(m.Results)-1].ValueType.(*BasicType)
+
+	return ok && last.Qualifier == "" && last.Name == "error"
+}
+
+// ErrorResults returns the zero values of the method's results followed by err, as the return values of a method
+// whose last result is an error
+func (m *Method) ErrorResults(err string) string {
+	values := make([]string, 0, len(m.Results))
+	for _, ident := range m.Results[:len(m.Results)-1] {
+		values = append(values, zeroValue(ident.ValueType))
+	}
+
+	return strings.Join(append(values, err), ", ")
+}
+
 // ParametersDeclaration returns the formal declaration syntax for the method's parameters
 func (m *Method) ParametersDeclaration() string {
 	if len(m.Parameters) == 0 {
@@ -111,3 +177,52 @@ func (m *Method) ResultsSignature() string {
 
 	return m.resultsSignature
 }
+
+// FakeName returns the name of the fake type implementing the method
+func (m *Method) FakeName() string {
+	return m.naming.fakeName(m.Interface)
+}
+
+// InvocationName returns the name of the type that records a call of the method
+func (m *Method) InvocationName() string {
+	return m.naming.invocationName(m.Interface, m.Name)
+}
+
+// InvocationConstructorName returns the name of the constructor for the method's invocation type
+func (m *Method) InvocationConstructorName() string {
+	return m.naming.invocationConstructorName(m.Interface, m.Name)
+}
+
+// HookName returns the name of the fake's field holding the method's hook
+func (m *Method) HookName() string {
+	return m.naming.hookName(m.Interface, m.Name)
+}
+
+// CallsName returns the name of the fake's field holding the method's calls
+func (m *Method) CallsName() string {
+	return m.naming.callsName(m.Interface, m.Name)
+}
+
+// ResultsName returns the name of the type holding the results of a call of the method
+func (m *Method) ResultsName() string {
+	return m.naming.resultsName(m.Interface, m.Name)
+}
+
+// commentLines formats text as line comments, each ending with a newline
+func commentLines(text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return ""
+	}
+
+	var comment strings.Builder
+	for _, line := range strings.Split(text, "\n") {
+		if line == "" {
+			comment.WriteString("//\n")
+			continue
+		}
+		comment.WriteString("// " + line + "\n")
+	}
+
+	return comment.String()
+}
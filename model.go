@@ -0,0 +1,196 @@
+package main
+
+import (
+	"encoding/json"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// Model is the serializable form of the interfaces given to plugins
+type Model struct {
+	CommandLine string            `json:"commandLine"`
+	Header      string            `json:"header,omitempty"`
+	BuildTags   string            `json:"buildTags,omitempty"`
+	PackageName string            `json:"packageName"`
+	Imports     []*ModelImport    `json:"imports"`
+	Interfaces  []*ModelInterface `json:"interfaces"`
+}
+
+// ModelImport is an import required by the interfaces
+type ModelImport struct {
+	Name  string `json:"name"`
+	Alias string `json:"alias,omitempty"`
+	Path  string `json:"path"`
+}
+
+// ModelInterface is an interface to fake
+type ModelInterface struct {
+	Name     string         `json:"name"`
+	Position *ModelPosition `json:"position,omitempty"`
+	Methods  []*ModelMethod `json:"methods"`
+}
+
+// ModelMethod is a method in an interface's method set
+type ModelMethod struct {
+	Name       string             `json:"name"`
+	Doc        string             `json:"doc,omitempty"`
+	Position   *ModelPosition     `json:"position,omitempty"`
+	Parameters []*ModelIdentifier `json:"parameters"`
+	Results    []*ModelIdentifier `json:"results"`
+}
+
+// ModelIdentifier is a method parameter or result
+type ModelIdentifier struct {
+	Name string     `json:"name"`
+	Type *ModelType `json:"type"`
+}
+
+// ModelType describes the type of an identifier.  Kind is one of "basic" (predeclared), "named", "literal", "pointer",
+// "array", "slice", "map", "chan" or "ellipsis".  Package is the import path of a named type, including the types
+// declared in the interface's package.  Expr is the type's Go syntax as used in the generated source.
+type ModelType struct {
+	Kind    string     `json:"kind"`
+	Expr    string     `json:"expr"`
+	Name    string     `json:"name,omitempty"`
+	Package string     `json:"package,omitempty"`
+	Len     string     `json:"len,omitempty"`
+	Dir     string     `json:"dir,omitempty"`
+	Key     *ModelType `json:"key,omitempty"`
+	Elem    *ModelType `json:"elem,omitempty"`
+}
+
+// ModelPosition is a source position
+type ModelPosition struct {
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+}
+
+// Model produces the JSON interface model for the named interfaces.
+func (g *Generator) Model(interfaceNames []string) ([]byte, error) {
+	decls, err := g.resolve(interfaceNames)
+	if err != nil {
+		return nil, err
+	}
+	local, err := packageImportPath(g.directory)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &Model{
+		CommandLine: g.commandLine(interfaceNames),
+		Header:      g.Header,
+		BuildTags:   g.BuildTags,
+		PackageName: g.outputPackageName(),
+		Imports:     []*ModelImport{},
+		Interfaces:  make([]*ModelInterface, len(decls)),
+	}
+	for _, imp := range g.requiredImports(decls) {
+		path, err := strconv.Unquote(imp.Path)
+		if err != nil {
+			path = imp.Path
+		}
+		model.Imports = append(model.Imports, &ModelImport{Name: imp.Name, Alias: imp.Alias, Path: path})
+	}
+	for i, decl := range decls {
+		model.Interfaces[i] = g.interfaceModel(decl, local)
+	}
+
+	return json.MarshalIndent(model, "", "  ")
+}
+
+// interfaceModel returns the model of the interface, local is the import path of the input package
+func (g *Generator) interfaceModel(decl *Interface, local string) *ModelInterface {
+	m := &ModelInterface{
+		Name:     decl.Name,
+		Position: g.positionModel(decl.pos),
+		Methods:  make([]*ModelMethod, len(decl.Methods)),
+	}
+	for i, method := range decl.Methods {
+		m.Methods[i] = &ModelMethod{
+			Name:       method.Name,
+			Doc:        method.Doc,
+			Position:   g.positionModel(method.pos),
+			Parameters: identifiersModel(method.Parameters, local),
+			Results:    identifiersModel(method.Results, local),
+		}
+	}
+
+	return m
+}
+
+func (g *Generator) positionModel(pos token.Pos) *ModelPosition {
+	if !pos.IsValid() || g.fileset == nil {
+		return nil
+	}
+	p := g.fileset.Position(pos)
+
+	return &ModelPosition{Filename: p.Filename, Line: p.Line, Column: p.Column}
+}
+
+func identifiersModel(idents []*Identifier, local string) []*ModelIdentifier {
+	m := make([]*ModelIdentifier, len(idents))
+	for i, ident := range idents {
+		m[i] = &ModelIdentifier{Name: ident.Name, Type: typeModel(ident.ValueType, local)}
+	}
+
+	return m
+}
+
+// typeModel returns the model of the type, local is the import path of the package declaring the unqualified named types
+func typeModel(t Type, local string) *ModelType {
+	m := &ModelType{Expr: t.ParameterFormat()}
+
+	switch actual := t.(type) {
+	case *BasicType:
+		m.Name = actual.Name
+		m.Package = actual.Path
+		switch {
+		case actual.Qualifier != "":
+			m.Kind = "named"
+		case types.Universe.Lookup(actual.Name) != nil:
+			m.Kind = "basic"
+		case strings.HasPrefix(actual.Name, "struct"), strings.HasPrefix(actual.Name, "interface"), strings.HasPrefix(actual.Name, "func"):
+			m.Kind = "literal"
+			m.Name = ""
+		default:
+			m.Kind = "named"
+			if m.Package == "" {
+				m.Package = local
+			}
+		}
+	case *Pointer:
+		m.Kind = "pointer"
+		m.Elem = typeModel(actual.subType, local)
+	case *Array:
+		m.Kind = "slice"
+		if actual.scale != "" {
+			m.Kind = "array"
+			m.Len = actual.scale
+		}
+		m.Elem = typeModel(actual.subType, local)
+	case *Ellipsis:
+		m.Kind = "ellipsis"
+		m.Elem = typeModel(actual.subType, local)
+	case *Map:
+		m.Kind = "map"
+		m.Key = typeModel(actual.keyType, local)
+		m.Elem = typeModel(actual.subType, local)
+	case *Channel:
+		m.Kind = "chan"
+		m.Dir = "both"
+		m.Elem = typeModel(actual.subType, local)
+	case *SendChannel:
+		m.Kind = "chan"
+		m.Dir = "send"
+		m.Elem = typeModel(actual.subType, local)
+	case *ReceiveChannel:
+		m.Kind = "chan"
+		m.Dir = "recv"
+		m.Elem = typeModel(actual.subType, local)
+	}
+
+	return m
+}
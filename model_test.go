@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModel(t *testing.T) {
+	g, err := parsePackage("testdata/importer", []string{"testdata/importer/importer_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	data, err := g.Model([]string{"Importer"})
+	if err != nil {
+		t.Fatalf("Generator.Model error: %s", err)
+	}
+
+	var model Model
+	if err := json.Unmarshal(data, &model); err != nil {
+		t.Fatalf("invalid model JSON: %s", err)
+	}
+
+	assert.Equal(t, "main", model.PackageName)
+	assert.Equal(t, []*ModelImport{{Name: "fmt", Alias: ".", Path: "fmt"}, {Name: "strings", Alias: "z", Path: "strings"}}, model.Imports)
+	if assert.Len(t, model.Interfaces, 1) {
+		intf := model.Interfaces[0]
+		assert.Equal(t, "Importer", intf.Name)
+		assert.Equal(t, &ModelPosition{Filename: "testdata/importer/importer_def.go", Line: 9, Column: 6}, intf.Position)
+		if assert.Len(t, intf.Methods, 1) {
+			method := intf.Methods[0]
+			assert.Equal(t, "Scan", method.Name)
+			assert.Equal(t, 10, method.Position.Line)
+			assert.Equal(t, &ModelType{Kind: "pointer", Expr: "*Scanner", Elem: &ModelType{Kind: "named", Expr: "Scanner", Name: "Scanner", Package: "fmt"}}, method.Parameters[0].Type)
+			assert.Equal(t, &ModelType{Kind: "named", Expr: "z.Reader", Name: "Reader", Package: "strings"}, method.Results[0].Type)
+		}
+	}
+}
+
+func TestModelLocalTypes(t *testing.T) {
+	g, err := parsePackage("testdata/recorder", []string{"testdata/recorder/recorder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	data, err := g.Model([]string{"Recorder"})
+	if err != nil {
+		t.Fatalf("Generator.Model error: %s", err)
+	}
+
+	var model Model
+	if err := json.Unmarshal(data, &model); err != nil {
+		t.Fatalf("invalid model JSON: %s", err)
+	}
+	assert.Equal(t, []*ModelImport{{Name: "context", Path: "context"}, {Name: "time", Path: "time"}}, model.Imports)
+	item := &ModelType{Kind: "named", Expr: "Item", Name: "Item", Package: "github.com/percolate/charlatan/testdata/recorder"}
+	assert.Equal(t, &ModelType{Kind: "pointer", Expr: "*Item", Elem: item}, model.Interfaces[0].Methods[0].Results[0].Type)
+}
+
+func TestModelImports(t *testing.T) {
+	g, err := LoadPackageDir("testdata/importer")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+
+	data, err := g.Model([]string{"Importer"})
+	if err != nil {
+		t.Fatalf("Generator.Model error: %s", err)
+	}
+
+	var model Model
+	if err := json.Unmarshal(data, &model); err != nil {
+		t.Fatalf("invalid model JSON: %s", err)
+	}
+	assert.Equal(t, []*ModelImport{{Name: "fmt", Alias: ".", Path: "fmt"}, {Name: "strings", Alias: "z", Path: "strings"}}, model.Imports)
+}
+
+func TestModelTypes(t *testing.T) {
+	g, err := parsePackage("testdata/channeler", []string{"testdata/channeler/channeler_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	decls, err := g.resolve([]string{"Channeler"})
+	if err != nil {
+		t.Fatalf("Generator.resolve error: %s", err)
+	}
+
+	model := g.interfaceModel(decls[0], "example.com/channeler")
+	for _, method := range model.Methods {
+		for _, ident := range append(method.Parameters, method.Results...) {
+			assert.NotEmpty(t, ident.Type.Kind)
+			assert.NotEmpty(t, ident.Type.Expr)
+		}
+	}
+	assert.Equal(t, "recv", model.Methods[1].Parameters[0].Type.Dir)
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+	"text/template"
+)
+
+// Naming holds the patterns used to name the generated declarations.  Each pattern is a template executed with a
+// NameParts value, empty patterns use the default.
+type Naming struct {
+	Fake                  string `json:"fake"`                  // the fake type, e.g. "Fake{{.Interface}}"
+	Constructor           string `json:"constructor"`           // the fake constructors, e.g. "New{{.Fake}}{{.Variant}}"
+	Invocation            string `json:"invocation"`            // the invocation types, e.g. "{{.Interface}}{{.Method}}Invocation"
+	InvocationConstructor string `json:"invocationConstructor"` // the invocation constructors, e.g. "New{{.Invocation}}"
+	Hook                  string `json:"hook"`                  // the hook fields, e.g. "{{.Method}}Hook"
+	Calls                 string `json:"calls"`                 // the calls fields, e.g. "{{.Method}}Calls"
+	Results               string `json:"results"`               // the results types of the stub sequences, e.g. "{{.Interface}}{{.Method}}Results"
+}
+
+// NameParts is given to the naming patterns.  Only the parts that are known before the name is produced are set,
+// e.g. Fake is not available to the fake type pattern.
+type NameParts struct {
+	Interface  string // the interface name
+	Method     string // the method name
+	Fake       string // the fake type name
+	Invocation string // the invocation type name
+	Variant    string // the constructor variant, e.g. "DefaultPanic"
+}
+
+var defaultNaming = Naming{
+	Fake:                  "Fake{{.Interface}}",
+	Constructor:           "New{{.Fake}}{{.Variant}}",
+	Invocation:            "{{.Interface}}{{.Method}}Invocation",
+	InvocationConstructor: "New{{.Invocation}}",
+	Hook:                  "{{.Method}}Hook",
+	Calls:                 "{{.Method}}Calls",
+	Results:               "{{.Interface}}{{.Method}}Results",
+}
+
+// Merge returns a copy of the naming with the non-empty patterns of other replacing its own
+func (n Naming) Merge(other Naming) Naming {
+	merge := func(value, override string) string {
+		if override != "" {
+			return override
+		}
+		return value
+	}
+
+	return Naming{
+		Fake:                  merge(n.Fake, other.Fake),
+		Constructor:           merge(n.Constructor, other.Constructor),
+		Invocation:            merge(n.Invocation, other.Invocation),
+		InvocationConstructor: merge(n.InvocationConstructor, other.InvocationConstructor),
+		Hook:                  merge(n.Hook, other.Hook),
+		Calls:                 merge(n.Calls, other.Calls),
+		Results:               merge(n.Results, other.Results),
+	}
+}
+
+// namer produces the names of generated declarations from the naming patterns
+type namer struct {
+	fake                  *template.Template
+	constructor           *template.Template
+	invocation            *template.Template
+	invocationConstructor *template.Template
+	hook                  *template.Template
+	calls                 *template.Template
+	results               *template.Template
+}
+
+func newNamer(naming Naming) (*namer, error) {
+	naming = defaultNaming.Merge(naming)
+	n := new(namer)
+	patterns := []struct {
+		name    string
+		pattern string
+		result  **template.Template
+	}{
+		{"fake", naming.Fake, &n.fake},
+		{"constructor", naming.Constructor, &n.constructor},
+		{"invocation", naming.Invocation, &n.invocation},
+		{"invocation constructor", naming.InvocationConstructor, &n.invocationConstructor},
+		{"hook", naming.Hook, &n.hook},
+		{"calls", naming.Calls, &n.calls},
+		{"results", naming.Results, &n.results},
+	}
+
+	sample := NameParts{Interface: "Interface", Method: "Method", Fake: "Fake", Invocation: "Invocation", Variant: "Variant"}
+	for _, p := range patterns {
+		t, err := template.New(p.name).Funcs(funky).Parse(p.pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error: invalid %s naming pattern: %s", p.name, err)
+		}
+		var name strings.Builder
+		if err := t.Execute(&name, sample); err != nil {
+			return nil, fmt.Errorf("error: invalid %s naming pattern: %s", p.name, err)
+		}
+		if !token.IsIdentifier(name.String()) {
+			return nil, fmt.Errorf("error: invalid %s naming pattern: %q does not produce an identifier", p.name, p.pattern)
+		}
+		*p.result = t
+	}
+
+	return n, nil
+}
+
+func (n *namer) execute(t *template.Template, parts NameParts) string {
+	var name strings.Builder
+	if err := t.Execute(&name, parts); err != nil {
+		// N.B. - patterns are validated when the namer is created
+		panic(fmt.Sprintf("internal error: naming pattern failed: %s", err))
+	}
+
+	return name.String()
+}
+
+func (n *namer) fakeName(intf string) string {
+	return n.execute(n.fake, NameParts{Interface: intf})
+}
+
+func (n *namer) constructorName(intf, variant string) string {
+	return n.execute(n.constructor, NameParts{Interface: intf, Fake: n.fakeName(intf), Variant: variant})
+}
+
+func (n *namer) invocationName(intf, method string) string {
+	return n.execute(n.invocation, NameParts{Interface: intf, Method: method, Fake: n.fakeName(intf)})
+}
+
+func (n *namer) invocationConstructorName(intf, method string) string {
+	parts := NameParts{Interface: intf, Method: method, Fake: n.fakeName(intf), Invocation: n.invocationName(intf, method)}
+	return n.execute(n.invocationConstructor, parts)
+}
+
+func (n *namer) hookName(intf, method string) string {
+	return n.execute(n.hook, NameParts{Interface: intf, Method: method, Fake: n.fakeName(intf)})
+}
+
+func (n *namer) callsName(intf, method string) string {
+	return n.execute(n.calls, NameParts{Interface: intf, Method: method, Fake: n.fakeName(intf)})
+}
+
+func (n *namer) resultsName(intf, method string) string {
+	return n.execute(n.results, NameParts{Interface: intf, Method: method, Fake: n.fakeName(intf)})
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamingMerge(t *testing.T) {
+	n := defaultNaming.Merge(Naming{Fake: "Mock{{.Interface}}"})
+
+	assert.Equal(t, "Mock{{.Interface}}", n.Fake)
+	assert.Equal(t, defaultNaming.Hook, n.Hook)
+}
+
+func TestNewNamerInvalid(t *testing.T) {
+	_, err := newNamer(Naming{Fake: "Mock{{.Interface"})
+	assert.Error(t, err)
+
+	_, err = newNamer(Naming{Hook: "{{.Unknown}}Hook"})
+	assert.Error(t, err)
+
+	_, err = newNamer(Naming{Calls: "{{.Method}} Calls"})
+	assert.EqualError(t, err, `error: invalid calls naming pattern: "{{.Method}} Calls" does not produce an identifier`)
+}
+
+func TestNamer(t *testing.T) {
+	n, err := newNamer(Naming{Fake: "{{.Interface}}Stub", Invocation: "{{.Fake}}{{.Method}}Call"})
+	if err != nil {
+		t.Fatalf("newNamer error: %s", err)
+	}
+
+	assert.Equal(t, "ServiceStub", n.fakeName("Service"))
+	assert.Equal(t, "NewServiceStubDefaultPanic", n.constructorName("Service", "DefaultPanic"))
+	assert.Equal(t, "ServiceStubFetchCall", n.invocationName("Service", "Fetch"))
+	assert.Equal(t, "NewServiceStubFetchCall", n.invocationConstructorName("Service", "Fetch"))
+	assert.Equal(t, "FetchHook", n.hookName("Service", "Fetch"))
+	assert.Equal(t, "FetchCalls", n.callsName("Service", "Fetch"))
+	assert.Equal(t, "ServiceFetchResults", n.resultsName("Service", "Fetch"))
+}
+
+func TestGenerateNaming(t *testing.T) {
+	g, err := parsePackage("testdata/embedder", []string{"testdata/embedder/embedder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	g.Naming = Naming{
+		Fake:                  "Mock{{.Interface}}",
+		Constructor:           "{{.Fake}}{{.Variant}}",
+		Invocation:            "{{.Method}}Of{{.Interface}}",
+		InvocationConstructor: "Make{{.Invocation}}",
+		Hook:                  "On{{.Method}}",
+		Calls:                 "{{.Method}}History",
+		Results:               "{{.Method}}Returns",
+	}
+	g.Features = AllFeatures
+
+	got, err := g.Generate([]string{"Embedder"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	src := string(got)
+	assert.Contains(t, src, "type MockEmbedder struct")
+	assert.Contains(t, src, "func MockEmbedderDefaultPanic() *MockEmbedder")
+	assert.Contains(t, src, "type EmbedOfEmbedder struct")
+	assert.Contains(t, src, "func MakeEmbedOfEmbedder(")
+	assert.Regexp(t, `OnEmbed\s+func\(string\) string`, src)
+	assert.Regexp(t, `EmbedHistory\s+\[\]\*EmbedOfEmbedder`, src)
+	assert.Contains(t, src, "type EmbedReturns struct")
+	assert.NotContains(t, src, "FakeEmbedder")
+	assert.NotContains(t, src, "EmbedHook")
+	assert.NotContains(t, src, "EmbedCalls")
+}
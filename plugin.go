@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// PluginFile is a file produced by a plugin.  Name is relative to the output directory.
+type PluginFile struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// PluginResponse is read from the standard output of a plugin
+type PluginResponse struct {
+	Files []*PluginFile `json:"files"`
+	Error string        `json:"error,omitempty"`
+}
+
+// RunPlugin runs the given command with the JSON model on its standard input and returns the files it produced.
+// The command is split on white space, it is not interpreted by a shell.
+func RunPlugin(command string, model []byte) ([]*PluginFile, error) {
+	argv := strings.Fields(command)
+	if len(argv) == 0 {
+		return nil, fmt.Errorf("error: empty plugin command")
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Stdin = bytes.NewReader(model)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error: plugin %s failed: %s", argv[0], err)
+	}
+
+	var response PluginResponse
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("error: invalid plugin response from %s: %s", argv[0], err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("error: plugin %s: %s", argv[0], response.Error)
+	}
+
+	for _, file := range response.Files {
+		name := filepath.Clean(file.Name)
+		if file.Name == "" || filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("error: plugin %s produced invalid file name %q", argv[0], file.Name)
+		}
+		file.Name = name
+	}
+
+	return response.Files, nil
+}
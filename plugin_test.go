@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPluginHelper is not a real test, it is run as a plugin by the tests below
+func TestPluginHelper(t *testing.T) {
+	mode := os.Getenv("CHARLATAN_PLUGIN_HELPER")
+	if mode == "" {
+		return
+	}
+	defer os.Exit(0)
+
+	var model Model
+	data, _ := ioutil.ReadAll(os.Stdin)
+	if err := json.Unmarshal(data, &model); err != nil {
+		fmt.Fprint(os.Stdout, `{"error": "bad model"}`)
+		return
+	}
+
+	var response PluginResponse
+	switch mode {
+	case "ok":
+		for _, intf := range model.Interfaces {
+			response.Files = append(response.Files, &PluginFile{Name: "out/" + intf.Name + ".txt", Content: intf.Methods[0].Name})
+		}
+	case "escape":
+		response.Files = []*PluginFile{{Name: "../escape.txt"}}
+	case "error":
+		response.Error = "failed"
+	}
+	json.NewEncoder(os.Stdout).Encode(&response)
+}
+
+func runPluginHelper(t *testing.T, mode string) ([]*PluginFile, error) {
+	os.Setenv("CHARLATAN_PLUGIN_HELPER", mode)
+	defer os.Unsetenv("CHARLATAN_PLUGIN_HELPER")
+
+	g, err := parsePackage("testdata/multireturner", []string{"testdata/multireturner/multireturner_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	model, err := g.Model([]string{"Multireturner"})
+	if err != nil {
+		t.Fatalf("Generator.Model error: %s", err)
+	}
+
+	return RunPlugin(os.Args[0]+" -test.run=^TestPluginHelper$", model)
+}
+
+func TestRunPlugin(t *testing.T) {
+	files, err := runPluginHelper(t, "ok")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*PluginFile{{Name: "out/Multireturner.txt", Content: "MultiReturn"}}, files)
+}
+
+func TestRunPluginError(t *testing.T) {
+	_, err := runPluginHelper(t, "error")
+
+	assert.EqualError(t, err, fmt.Sprintf("error: plugin %s: failed", os.Args[0]))
+}
+
+func TestRunPluginInvalidFileName(t *testing.T) {
+	_, err := runPluginHelper(t, "escape")
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,606 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"golang.org/x/tools/imports"
+)
+
+const recordingSource = `{{with .Header}}{{.}}
+
+{{end}}// Calls recorded in {{.Recording}}, generated by "{{.CommandLine}}".  Edit as needed.
+{{with .BuildTags}}
+//go:build {{.}}
+{{end}}
+package {{.PackageName}}
+
+{{range .Imports}}import {{.}}
+{{end}}
+{{range $f := .Fakes}}
+// Set{{$f.FakeName}}Recording configures f to return the results of the calls of {{$f.Name}} recorded in {{$.Recording}}
+func Set{{$f.FakeName}}Recording(f *{{$f.FakeName}}) {
+{{range $m := $f.Methods}}{{if not $m.Results}}	f.{{$m.HookName}} = func({{$m.ParametersSignature}}) {}
+{{else if not $m.Parameters}}	f.Set{{$m.Name}}StubSequence(
+{{range $m.Calls}}		{{$m.ResultsName}}{ {{- range $idx, $r := .Results}}{{if $idx}}, {{end}}{{index $m.ResultNames $idx}}: {{$r}}{{end -}} },
+{{end}}	)
+{{else if $m.Omitted}}{{range $m.Calls}}	f.Set{{$m.Name}}InvocationMatch({{join ", " .Matchers}}, {{join ", " .Results}})
+{{end}}{{else}}	f.Set{{$m.Name}}Invocation([]*{{$m.InvocationName}}{
+{{range $m.Calls}}		{{$m.InvocationConstructorName}}({{join ", " .Parameters}}, {{join ", " .Results}}),
+{{end}}	}, func() ({{$m.ResultsSignature}}) {
+		panic("{{$m.Interface}}.{{$m.Name}}() called with parameters not recorded in {{$.Recording}}")
+	})
+{{end}}{{end}}}
+{{if $.Features.Assert}}
+// Assert{{$f.FakeName}}Recording calls t.Error if the calls of {{$f.Name}} recorded in {{$.Recording}} were not made to f
+func Assert{{$f.FakeName}}Recording(t {{$.TestingT.Type $f.Name}}, f *{{$f.FakeName}}) {
+	t.Helper()
+{{range $m := $f.Methods}}{{if not $m.Parameters}}	f.Assert{{$m.Name}}CalledN(t, {{len $m.Calls}})
+{{else if $m.Omitted}}{{range $m.Calls}}	f.Assert{{$m.Name}}CalledWithMatch(t, {{join ", " .Matchers}})
+{{end}}{{else}}{{range $m.Calls}}	f.Assert{{$m.Name}}CalledWith(t, {{join ", " .Parameters}})
+{{end}}{{end}}{{end}}}
+{{end}}{{end}}`
+
+var recordingTmpl = template.Must(template.New("recording").Funcs(funky).Parse(recordingSource))
+
+// recordingTemplate is the model of the test source produced from a recording
+type recordingTemplate struct {
+	CommandLine string
+	Header      string
+	BuildTags   string
+	PackageName string
+	Recording   string   // the base name of the recording file
+	Imports     []string // the quoted import paths, with an alias if needed
+	Fakes       []*recordedFake
+	Features    Features
+	TestingT    TestingT
+}
+
+// recordedFake holds the recorded calls of an interface
+type recordedFake struct {
+	*Interface
+	Methods []*recordedMethod
+}
+
+// recordedMethod holds the recorded calls of a method, without duplicates
+type recordedMethod struct {
+	*Method
+	Omitted bool // true if some parameters were not recorded, the calls are then matched on the others
+	Calls   []*recordedCall
+	seen    map[string]bool
+}
+
+// ResultNames returns the names of the fields of the method's results type
+func (m *recordedMethod) ResultNames() []string {
+	names := make([]string, len(m.Method.Results))
+	for i, r := range m.Method.Results {
+		names[i] = r.TitleCase()
+	}
+
+	return names
+}
+
+// recordedCall holds the Go expressions of the parameters and results of a recorded call
+type recordedCall struct {
+	Parameters []string
+	Matchers   []string
+	Results    []string
+}
+
+// recordingEntry is an entry of a cassette written by the NewFake*Record constructors
+type recordingEntry struct {
+	Interface  string                     `json:"interface"`
+	Method     string                     `json:"method"`
+	Parameters map[string]json.RawMessage `json:"parameters"`
+	Results    map[string]json.RawMessage `json:"results"`
+}
+
+// GenerateRecording produces test source that configures the fakes of the named interfaces to return the results of
+// the calls recorded in a cassette, see NewFake*Record, and asserts that the calls are made.  Calls of other
+// interfaces are ignored.
+func (g *Generator) GenerateRecording(interfaceNames []string, path string) ([]byte, error) {
+	for _, feature := range []string{"invocations", "invocation-ctors"} {
+		if !*g.Features.lookup(feature) {
+			return nil, fmt.Errorf("error: a recording requires the %q feature", feature)
+		}
+	}
+
+	decls, err := g.resolve(interfaceNames)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error: cannot read recording: %s", err)
+	}
+	var entries []*recordingEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("error: cannot read recording %s: %s", path, err)
+	}
+
+	r := &recorder{generator: g, imports: make(map[string]string)}
+	model := recordingTemplate{
+		CommandLine: g.commandLine(interfaceNames),
+		Header:      g.Header,
+		BuildTags:   g.BuildTags,
+		PackageName: g.outputPackageName(),
+		Recording:   filepath.Base(path),
+		Features:    g.Features,
+		TestingT:    g.TestingT,
+	}
+	fakes := make(map[string]*recordedFake, len(decls))
+	for _, decl := range decls {
+		if decl.typ == nil {
+			return nil, fmt.Errorf("error: interface %q: cannot use a recording without type information", decl.Name)
+		}
+		fake := &recordedFake{Interface: decl}
+		for _, m := range decl.Methods {
+			fake.Methods = append(fake.Methods, &recordedMethod{Method: m, seen: make(map[string]bool)})
+		}
+		fakes[decl.Name] = fake
+		model.Fakes = append(model.Fakes, fake)
+	}
+
+	for _, entry := range entries {
+		fake, ok := fakes[entry.Interface]
+		if !ok {
+			continue
+		}
+		if err := r.add(fake, entry); err != nil {
+			return nil, fmt.Errorf("error: recording %s: %s", path, err)
+		}
+	}
+
+	// N.B. - methods without recorded calls keep the default behavior of the fake
+	for _, fake := range model.Fakes {
+		recorded := fake.Methods[:0]
+		for _, m := range fake.Methods {
+			if len(m.Calls) == 0 {
+				continue
+			}
+			if m.Omitted && !g.Features.Matchers {
+				return nil, fmt.Errorf("error: recording %s: %s.%s has parameters that are not recorded, which requires the \"matchers\" feature", path, m.Interface, m.Name)
+			}
+			if len(m.Parameters) == 0 && len(m.Results) != 0 && !g.Features.Sequences {
+				return nil, fmt.Errorf("error: recording %s: %s.%s has no parameters, its calls require the \"sequences\" feature", path, m.Interface, m.Name)
+			}
+			recorded = append(recorded, m)
+		}
+		fake.Methods = recorded
+	}
+
+	paths := make([]string, 0, len(r.imports))
+	for path := range r.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		model.Imports = append(model.Imports, r.imports[path])
+	}
+
+	var buf bytes.Buffer
+	if err := recordingTmpl.Execute(&buf, model); err != nil {
+		return nil, err
+	}
+	src, err := imports.Process("", buf.Bytes(), nil)
+	if err != nil {
+		return buf.Bytes(), fmt.Errorf("internal error: invalid code generated: %s", err)
+	}
+
+	return src, nil
+}
+
+// recorder converts recorded values to Go expressions
+type recorder struct {
+	generator *Generator
+	imports   map[string]string // the import specs required by the expressions, by path
+}
+
+// add converts a recorded call of the fake, ignoring duplicates
+func (r *recorder) add(fake *recordedFake, entry *recordingEntry) error {
+	var method *recordedMethod
+	for _, m := range fake.Methods {
+		if m.Name == entry.Method {
+			method = m
+			break
+		}
+	}
+	if method == nil {
+		return fmt.Errorf("unknown method %s.%s", entry.Interface, entry.Method)
+	}
+	var signature *types.Signature
+	for i := 0; i < fake.typ.NumMethods(); i++ {
+		if fake.typ.Method(i).Name() == entry.Method {
+			signature = fake.typ.Method(i).Type().(*types.Signature)
+		}
+	}
+	if signature == nil || signature.Params().Len() != len(method.Parameters) || signature.Results().Len() != len(method.Results) {
+		return fmt.Errorf("no type information for method %s.%s", entry.Interface, entry.Method)
+	}
+
+	call := new(recordedCall)
+	for i, p := range method.Parameters {
+		if cassette(p.ValueType) == cassetteOmit {
+			method.Omitted = true
+			call.Parameters = append(call.Parameters, zeroValue(p.ValueType))
+			call.Matchers = append(call.Matchers, "CharlatanAny()")
+			continue
+		}
+		expr, err := r.expression(entry.Parameters[p.Name], signature.Params().At(i).Type(), p.ValueType)
+		if err != nil {
+			return fmt.Errorf("%s.%s parameter %s: %s", entry.Interface, entry.Method, p.Name, err)
+		}
+		call.Parameters = append(call.Parameters, expr)
+		call.Matchers = append(call.Matchers, "CharlatanEq("+expr+")")
+	}
+	for i, res := range method.Results {
+		if cassette(res.ValueType) == cassetteOmit {
+			call.Results = append(call.Results, zeroValue(res.ValueType))
+			continue
+		}
+		expr, err := r.expression(entry.Results[res.Name], signature.Results().At(i).Type(), res.ValueType)
+		if err != nil {
+			return fmt.Errorf("%s.%s result %s: %s", entry.Interface, entry.Method, res.Name, err)
+		}
+		call.Results = append(call.Results, expr)
+	}
+
+	// N.B. - only the first call with given parameters can be returned by Set*Invocation, calls of methods without
+	// parameters are all kept for the stub sequence
+	key := strings.Join(call.Parameters, ", ")
+	if len(method.Parameters) != 0 && method.seen[key] {
+		return nil
+	}
+	method.seen[key] = true
+	method.Calls = append(method.Calls, call)
+
+	return nil
+}
+
+// expression returns the Go expression of a recorded parameter or result of the given type
+func (r *recorder) expression(raw json.RawMessage, t types.Type, model Type) (string, error) {
+	if cassette(model) == cassetteError {
+		var message *string
+		if len(raw) != 0 {
+			if err := json.Unmarshal(raw, &message); err != nil {
+				return "", err
+			}
+		}
+		if message == nil {
+			return "nil", nil
+		}
+		r.imports["errors"] = strconv.Quote("errors")
+		return fmt.Sprintf("errors.New(%s)", strconv.Quote(*message)), nil
+	}
+	if len(raw) == 0 {
+		return zeroValue(model), nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+
+	return r.literal(value, t)
+}
+
+// literal returns a Go expression of the given type for a value decoded from JSON.  Values of types that are not
+// represented by literals, e.g. time.Time, are decoded from JSON when the expression is evaluated.
+func (r *recorder) literal(value interface{}, t types.Type) (string, error) {
+	if expr, ok := r.timeLiteral(value, t); ok {
+		return expr, nil
+	}
+	if unmarshaler(t) {
+		return r.decoded(value, t)
+	}
+
+	if value == nil {
+		switch t.Underlying().(type) {
+		case *types.Pointer, *types.Slice, *types.Map, *types.Interface, *types.Signature, *types.Chan:
+			return "nil", nil
+		}
+		return fmt.Sprintf("*new(%s)", r.typeString(t)), nil
+	}
+
+	switch actual := t.Underlying().(type) {
+	case *types.Basic:
+		switch v := value.(type) {
+		case string:
+			if actual.Info()&types.IsString != 0 {
+				return strconv.Quote(v), nil
+			}
+		case bool:
+			if actual.Info()&types.IsBoolean != 0 {
+				return strconv.FormatBool(v), nil
+			}
+		case json.Number:
+			if actual.Info()&types.IsNumeric != 0 {
+				return v.String(), nil
+			}
+		}
+	case *types.Pointer:
+		if _, ok := actual.Elem().Underlying().(*types.Struct); ok && !unmarshaler(actual.Elem()) {
+			elem, err := r.literal(value, actual.Elem())
+			if err != nil {
+				return "", err
+			}
+			return "&" + elem, nil
+		}
+	case *types.Slice:
+		if basic, ok := actual.Elem().(*types.Basic); ok && basic.Kind() == types.Byte {
+			encoded, ok := value.(string)
+			if !ok {
+				break
+			}
+			decoded, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%s(%s)", r.typeString(t), strconv.Quote(string(decoded))), nil
+		}
+		return r.elements(value, t, actual.Elem())
+	case *types.Array:
+		return r.elements(value, t, actual.Elem())
+	case *types.Map:
+		return r.entries(value, t, actual.Key(), actual.Elem())
+	case *types.Struct:
+		return r.fields(value, t, actual)
+	case *types.Interface:
+		if actual.Empty() {
+			return r.dynamic(value), nil
+		}
+	}
+
+	return r.decoded(value, t)
+}
+
+// elements returns the composite literal of a slice or array
+func (r *recorder) elements(value interface{}, t, elem types.Type) (string, error) {
+	values, ok := value.([]interface{})
+	if !ok {
+		return r.decoded(value, t)
+	}
+
+	exprs := make([]string, len(values))
+	for i, v := range values {
+		expr, err := r.literal(v, elem)
+		if err != nil {
+			return "", err
+		}
+		exprs[i] = expr
+	}
+
+	return fmt.Sprintf("%s{%s}", r.typeString(t), strings.Join(exprs, ", ")), nil
+}
+
+// entries returns the composite literal of a map, sorted by key
+func (r *recorder) entries(value interface{}, t, key, elem types.Type) (string, error) {
+	values, ok := value.(map[string]interface{})
+	basic, isBasic := key.Underlying().(*types.Basic)
+	if !ok || !isBasic || basic.Info()&(types.IsString|types.IsInteger) == 0 || unmarshaler(key) {
+		return r.decoded(value, t)
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	exprs := make([]string, len(keys))
+	for i, k := range keys {
+		keyExpr := strconv.Quote(k)
+		if basic.Info()&types.IsInteger != 0 {
+			if _, err := strconv.ParseInt(k, 10, 64); err != nil {
+				return "", fmt.Errorf("invalid map key %q", k)
+			}
+			keyExpr = k
+		}
+		expr, err := r.literal(values[k], elem)
+		if err != nil {
+			return "", err
+		}
+		exprs[i] = keyExpr + ": " + expr
+	}
+
+	return fmt.Sprintf("%s{%s}", r.typeString(t), strings.Join(exprs, ", ")), nil
+}
+
+// fields returns the composite literal of a struct, the recorded fields are matched as by encoding/json: a key
+// matches the field of the same name, or else the first field in declaration order whose name matches regardless
+// of case
+func (r *recorder) fields(value interface{}, t types.Type, s *types.Struct) (string, error) {
+	values, ok := value.(map[string]interface{})
+	if !ok {
+		return r.decoded(value, t)
+	}
+
+	var fields []*types.Var
+	var names []string
+	for i := 0; i < s.NumFields(); i++ {
+		field := s.Field(i)
+		if field.Embedded() {
+			return r.decoded(value, t)
+		}
+		if !field.Exported() {
+			continue
+		}
+		name := field.Name()
+		if tag, ok := reflect.StructTag(s.Tag(i)).Lookup("json"); ok {
+			if tag == "-" {
+				continue
+			}
+			parts := strings.Split(tag, ",")
+			if len(parts) > 1 && parts[1] == "string" {
+				return r.decoded(value, t)
+			}
+			if parts[0] != "" {
+				name = parts[0]
+			}
+		}
+		fields = append(fields, field)
+		names = append(names, name)
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	matched := make([]interface{}, len(fields))
+	found := make([]bool, len(fields))
+	exact := make([]bool, len(fields))
+	for _, k := range keys {
+		i, ok := fieldIndex(names, k)
+		if i < 0 || exact[i] {
+			continue
+		}
+		matched[i], found[i], exact[i] = values[k], true, ok
+	}
+
+	var exprs []string
+	for i, field := range fields {
+		if !found[i] {
+			continue
+		}
+		expr, err := r.literal(matched[i], field.Type())
+		if err != nil {
+			return "", err
+		}
+		exprs = append(exprs, field.Name()+": "+expr)
+	}
+
+	return fmt.Sprintf("%s{%s}", r.typeString(t), strings.Join(exprs, ", ")), nil
+}
+
+// dynamic returns the expression of a value decoded by encoding/json into an empty interface
+func (r *recorder) dynamic(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return strconv.Quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return fmt.Sprintf("float64(%s)", v)
+	case []interface{}:
+		exprs := make([]string, len(v))
+		for i, elem := range v {
+			exprs[i] = r.dynamic(elem)
+		}
+		return fmt.Sprintf("[]interface{}{%s}", strings.Join(exprs, ", "))
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		exprs := make([]string, len(keys))
+		for i, k := range keys {
+			exprs[i] = strconv.Quote(k) + ": " + r.dynamic(v[k])
+		}
+		return fmt.Sprintf("map[string]interface{}{%s}", strings.Join(exprs, ", "))
+	}
+
+	return "nil"
+}
+
+// timeLiteral returns a time.Date expression for a recorded time.Time in UTC
+func (r *recorder) timeLiteral(value interface{}, t types.Type) (string, bool) {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != "time" || named.Obj().Name() != "Time" {
+		return "", false
+	}
+	text, ok := value.(string)
+	if !ok {
+		return "", false
+	}
+	at, err := time.Parse(time.RFC3339Nano, text)
+	if err != nil || at.Location() != time.UTC {
+		return "", false
+	}
+	r.imports["time"] = strconv.Quote("time")
+
+	return fmt.Sprintf("time.Date(%d, time.%s, %d, %d, %d, %d, %d, time.UTC)", at.Year(), at.Month(), at.Day(), at.Hour(), at.Minute(), at.Second(), at.Nanosecond()), true
+}
+
+// decoded returns an expression decoding the value from JSON when it is evaluated
+func (r *recorder) decoded(value interface{}, t types.Type) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	r.imports["encoding/json"] = strconv.Quote("encoding/json")
+
+	return fmt.Sprintf("func() (value %s) {\n\tif err := json.Unmarshal([]byte(%s), &value); err != nil {\n\t\tpanic(err)\n\t}\n\treturn\n}()", r.typeString(t), strconv.Quote(string(data))), nil
+}
+
+// typeString returns the type as written in the output source, recording the imports it requires
+func (r *recorder) typeString(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		g := r.generator
+		if pkg == g.pkg {
+			if g.outputPackageName() == g.packageName {
+				return ""
+			}
+			if path, err := packageImportPath(g.directory); err == nil {
+				r.imports[path] = importSpec(g.packageName, path)
+			}
+			return g.packageName
+		}
+		r.imports[pkg.Path()] = importSpec(pkg.Name(), pkg.Path())
+		return pkg.Name()
+	})
+}
+
+// importSpec returns the import of the path, with an alias if its name is not the last element of the path
+func importSpec(name, path string) string {
+	if filepath.Base(path) == name {
+		return strconv.Quote(path)
+	}
+
+	return name + " " + strconv.Quote(path)
+}
+
+// unmarshaler returns true if values of the type decode themselves from JSON or text
+func unmarshaler(t types.Type) bool {
+	methods := types.NewMethodSet(types.NewPointer(t))
+	for _, name := range []string{"UnmarshalJSON", "UnmarshalText"} {
+		if methods.Lookup(nil, name) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldIndex returns the index of the field name matching the key, and true if it matches exactly, or -1
+func fieldIndex(names []string, key string) (int, bool) {
+	for i, name := range names {
+		if name == key {
+			return i, true
+		}
+	}
+	for i, name := range names {
+		if strings.EqualFold(name, key) {
+			return i, false
+		}
+	}
+
+	return -1, false
+}
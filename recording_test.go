@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateRecording(t *testing.T) {
+	g, err := parsePackage("testdata/recorder", []string{"testdata/recorder/recorder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	g.Features = AllFeatures
+	g.CommandLine = "charlatan -dir=testdata/recorder -features=all -from-recording=testdata/recorder/calls.json -output=testdata/recorder/calls_recording_test.go Recorder"
+
+	expected, err := ioutil.ReadFile("testdata/recorder/calls_recording_test.go")
+	if err != nil {
+		t.Fatalf("cannot read golden recording: %s", err)
+	}
+	src, err := g.GenerateRecording([]string{"Recorder"}, "testdata/recorder/calls.json")
+	assert.Nil(t, err)
+	assert.Equal(t, string(expected), string(src))
+}
+
+func TestValidateRecording(t *testing.T) {
+	g, err := LoadPackageDir("testdata/recorder")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+	g.Features = AllFeatures
+
+	src, err := g.GenerateRecording([]string{"Recorder"}, "testdata/recorder/calls.json")
+	if err != nil {
+		t.Fatalf("Generator.GenerateRecording error: %s", err)
+	}
+	err = g.Validate([]string{"Recorder"}, map[string][]byte{"testdata/recorder/calls_recording_test.go": src})
+	assert.Nil(t, err)
+
+	err = g.Validate([]string{"Recorder"}, map[string][]byte{"testdata/recorder/calls_recording_test.go": bytes.Replace(src, []byte("SetLookupInvocationMatch"), []byte("SetLookupInvocationMatches"), 1)})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "has no field or method SetLookupInvocationMatches")
+	}
+}
+
+func TestGenerateRecordingSkipsOtherInterfaces(t *testing.T) {
+	g, err := parsePackage("testdata/cassetter", []string{"testdata/cassetter/cassetter_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	src, err := g.GenerateRecording([]string{"Cassetter"}, "testdata/recorder/calls.json")
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "func SetFakeCassetterRecording(f *FakeCassetter) {\n}")
+	assert.NotContains(t, string(src), "Lookup")
+}
+
+func TestGenerateRecordingErrors(t *testing.T) {
+	g, err := parsePackage("testdata/recorder", []string{"testdata/recorder/recorder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	_, err = g.GenerateRecording([]string{"Recorder"}, "testdata/recorder/missing.json")
+	assert.EqualError(t, err, "error: cannot read recording: open testdata/recorder/missing.json: no such file or directory")
+
+	_, err = g.GenerateRecording([]string{"Recorder"}, "testdata/recorder/recorder_def.go")
+	assert.Error(t, err)
+
+	_, err = g.GenerateRecording([]string{"Recorder"}, "testdata/recorder/calls.json")
+	assert.EqualError(t, err, "error: recording testdata/recorder/calls.json: Recorder.Lookup has parameters that are not recorded, which requires the \"matchers\" feature")
+
+	g.Features = AllFeatures
+	g.Features.InvocationConstructors = false
+	_, err = g.GenerateRecording([]string{"Recorder"}, "testdata/recorder/calls.json")
+	assert.EqualError(t, err, "error: a recording requires the \"invocation-ctors\" feature")
+}
+
+func TestGenerateRecordingWithoutAssert(t *testing.T) {
+	g, err := parsePackage("testdata/recorder", []string{"testdata/recorder/recorder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	g.Features = AllFeatures
+	g.Features.Assert = false
+
+	src, err := g.GenerateRecording([]string{"Recorder"}, "testdata/recorder/calls.json")
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "func SetFakeRecorderRecording(f *FakeRecorder) {")
+	assert.NotContains(t, string(src), "AssertFakeRecorderRecording")
+}
+
+func TestFieldIndex(t *testing.T) {
+	names := []string{"id", "Name", "NAME"}
+
+	i, exact := fieldIndex(names, "NAME")
+	assert.Equal(t, 2, i)
+	assert.True(t, exact)
+
+	i, exact = fieldIndex(names, "name")
+	assert.Equal(t, 1, i)
+	assert.False(t, exact)
+
+	i, _ = fieldIndex(names, "missing")
+	assert.Equal(t, -1, i)
+}
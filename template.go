@@ -3,21 +3,38 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"golang.org/x/tools/imports"
 )
 
-const sourceTemplate = `// generated by "{{.CommandLine}}".  DO NOT EDIT.
+const sourceTemplate = `{{with .Header}}{{.}}
 
+{{end}}// Code generated by "{{.CommandLine}}".  DO NOT EDIT.
+{{with .BuildTags}}
+//go:build {{.}}
+{{end}}
 package {{.PackageName}}
 
 {{if .NeedsReflect}}import "reflect"{{end}}
 {{range .Imports}}import {{if .Alias}}{{.Alias}} {{end}}{{.Path}}
+{{end}}{{if and .Features.Sync .Interfaces}}import "sync"
+{{end}}{{if and .Features.TestingT (eq .TestingT "tb")}}import "testing"
+{{end}}{{if and .Features.Order .Shared}}import "sort"
+import "strings"
+import "sync/atomic"
+{{end}}{{if and .Features.Cassettes .Interfaces}}{{if not (.Imported "encoding/json")}}import "encoding/json"
+{{end}}{{if not (.Imported "os")}}import "os"
+{{end}}{{end}}{{if and .Features.Cassettes .Shared}}import "errors"
 {{end}}
-{{range $i := .Interfaces}}{{range .Methods}}
-// {{.Interface}}{{.Name}}Invocation represents a single call of Fake{{.Interface}}.{{.Name}}
-type {{.Interface}}{{.Name}}Invocation struct {
+{{if .Shared}}{{template "shared" .}}{{end}}
+{{range $i := .Interfaces}}{{if $.Features.InvocationTypes}}{{range .Methods}}
+// {{.InvocationName}} represents a single call of {{.FakeName}}.{{.Name}}
+{{.DeprecatedComment}}type {{.InvocationName}} struct {
 {{if .Parameters}}	Parameters struct {
 {{range .Parameters}}	{{.FieldFormat}}
 {{end}}
@@ -26,12 +43,79 @@ type {{.Interface}}{{.Name}}Invocation struct {
 {{range .Results}}	{{.FieldFormat}}
 {{end}}
 	}{{end}}
+{{if $.Features.Order}}	Sequence uint64 // the position of the call among the calls of all the fakes in the package
+{{end}}}
+{{if $.Features.Order}}
+// CallName returns the name of the method called, "{{.FakeName}}.{{.Name}}"
+func (i *{{.InvocationName}}) CallName() string {
+	return "{{.FakeName}}.{{.Name}}"
+}
+
+// CallSequence returns the position of the call among the calls of all the fakes in the package
+func (i *{{.InvocationName}}) CallSequence() uint64 {
+	return i.Sequence
+}
+
+func (i *{{.InvocationName}}) charlatanCall() {}
+{{end}}{{if $.Features.Cassettes}}
+// charlatan{{.InvocationName}}JSON is the encoding of {{.InvocationName}} in cassettes
+type charlatan{{.InvocationName}}JSON struct {
+	Interface string {{jsonTag "interface"}}
+	Method    string {{jsonTag "method"}}
+{{if .Parameters}}	Parameters struct {
+{{range .Parameters}}{{$c := cassette .ValueType}}{{if eq $c "error"}}		{{.TitleCase}} *string {{jsonTag .Name}}
+{{else if eq $c "value"}}		{{.FieldFormat}} {{jsonTag .Name}}
+{{end}}{{end}}	} {{jsonTag "parameters"}}
+{{end}}{{if .Results}}	Results struct {
+{{range .Results}}{{$c := cassette .ValueType}}{{if eq $c "error"}}		{{.TitleCase}} *string {{jsonTag .Name}}
+{{else if eq $c "value"}}		{{.FieldFormat}} {{jsonTag .Name}}
+{{end}}{{end}}	} {{jsonTag "results"}}
+{{end}}}
+
+// charlatanCassette returns the encoding of the call in cassettes
+func (i *{{.InvocationName}}) charlatanCassette() *charlatan{{.InvocationName}}JSON {
+	entry := &charlatan{{.InvocationName}}JSON{Interface: "{{.Interface}}", Method: "{{.Name}}"}
+{{range .Parameters}}{{$c := cassette .ValueType}}{{if eq $c "error"}}	entry.Parameters.{{.TitleCase}} = charlatanErrorMessage(i.Parameters.{{.TitleCase}})
+{{else if eq $c "value"}}	entry.Parameters.{{.TitleCase}} = i.Parameters.{{.TitleCase}}
+{{end}}{{end}}{{range .Results}}{{$c := cassette .ValueType}}{{if eq $c "error"}}	entry.Results.{{.TitleCase}} = charlatanErrorMessage(i.Results.{{.TitleCase}})
+{{else if eq $c "value"}}	entry.Results.{{.TitleCase}} = i.Results.{{.TitleCase}}
+{{end}}{{end}}
+	return entry
 }
 
-{{if and .Parameters .Results}}
-// New{{.Interface}}{{.Name}}Invocation creates a new instance of {{.Interface}}{{.Name}}Invocation
-func New{{.Interface}}{{.Name}}Invocation({{range .Parameters}}{{.Name}} {{.ValueType.FieldFormat}}, {{end}}{{.ResultsDeclaration}}) *{{.Interface}}{{.Name}}Invocation {
-	invocation := new({{.Interface}}{{.Name}}Invocation)
+// charlatanCassetteKey returns the encoded parameters of the call, which identify it in a cassette
+func (i *{{.InvocationName}}) charlatanCassetteKey() (string, error) {
+{{if .Parameters}}	data, err := json.Marshal(i.charlatanCassette().Parameters)
+
+	return string(data), err
+{{else}}	return "", nil
+{{end}}}
+
+// MarshalJSON encodes the call as an entry of a cassette.  Parameters and results that cannot be encoded, such as
+// contexts, funcs and channels, are omitted, and errors are encoded as their messages.
+func (i {{.InvocationName}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.charlatanCassette())
+}
+
+// UnmarshalJSON decodes the call from an entry of a cassette
+func (i *{{.InvocationName}}) UnmarshalJSON(data []byte) error {
+	var entry charlatan{{.InvocationName}}JSON
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+
+{{range .Parameters}}{{$c := cassette .ValueType}}{{if eq $c "error"}}	i.Parameters.{{.TitleCase}} = charlatanError(entry.Parameters.{{.TitleCase}})
+{{else if eq $c "value"}}	i.Parameters.{{.TitleCase}} = entry.Parameters.{{.TitleCase}}
+{{end}}{{end}}{{range .Results}}{{$c := cassette .ValueType}}{{if eq $c "error"}}	i.Results.{{.TitleCase}} = charlatanError(entry.Results.{{.TitleCase}})
+{{else if eq $c "value"}}	i.Results.{{.TitleCase}} = entry.Results.{{.TitleCase}}
+{{end}}{{end}}
+	return nil
+}
+{{end}}
+{{if and $.Features.InvocationConstructors .Parameters .Results}}
+// {{.InvocationConstructorName}} creates a new instance of {{.InvocationName}}
+{{.DeprecatedComment}}func {{.InvocationConstructorName}}({{range .Parameters}}{{.Name}} {{.ValueType.FieldFormat}}, {{end}}{{.ResultsDeclaration}}) *{{.InvocationName}} {
+	invocation := new({{.InvocationName}})
 
 {{range .Parameters}} invocation.Parameters.{{.TitleCase}} = {{.Name}}
 {{end}}
@@ -40,60 +124,71 @@ func New{{.Interface}}{{.Name}}Invocation({{range .Parameters}}{{.Name}} {{.Valu
 
 	return invocation
 }{{end}}
-{{end}}{{/* end range .Methods */}}
-
+{{end}}{{/* end range .Methods */}}{{end}}{{/* end if $.Features.InvocationTypes */}}
+{{if and $.Features.TestingT $.TestingT.PerInterface}}
 // {{.Name}}TestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
 type {{.Name}}TestingT interface {
 	Error(...interface{})
 	Errorf(string, ...interface{})
 	Fatal(...interface{})
 	Helper()
-}
+}{{end}}
 
 /*
-Fake{{.Name}} is a mock implementation of {{.Name}} for testing.
+{{.FakeName}} is a mock implementation of {{.Name}} for testing.
 {{if .Methods}}{{with $m := index .Methods 0}}Use it in your tests as in this example:
 
 	package example
 
 	func TestWith{{$m.Interface}}(t *testing.T) {
-		f := &{{$.PackageName}}.Fake{{$m.Interface}}{
-			{{$m.Name}}Hook: func({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
+		f := &{{$.PackageName}}.{{$m.FakeName}}{
+			{{$m.HookName}}: func({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
 				// ensure parameters meet expectations, signal errors using t, etc
 				return
 			},
 		}
 
 		// test code goes here ...
-
-		// assert state of Fake{{.Name}} ...
+{{if $.Features.Assert}}
+		// assert state of {{.FakeName}} ...
 		f.Assert{{$m.Name}}CalledOnce(t)
-	}
+{{end}}	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to Fake{{.Name}}.
+unexpected calls are made to {{.FakeName}}.
 {{end}}{{end}}*/
-type Fake{{.Name}} struct {
-{{range .Methods}} {{.Name}}Hook func({{.ParametersSignature}}) ({{.ResultsSignature}})
-{{end}}
-{{range .Methods}} {{.Name}}Calls []*{{.Interface}}{{.Name}}Invocation
+type {{.FakeName}} struct {
+{{range .Methods}}{{.DocComment}} {{.HookName}} func({{.ParametersSignature}}) ({{.ResultsSignature}})
+{{end}}{{if $.Features.Calls}}
+{{range .Methods}} {{.CallsName}} []*{{.InvocationName}}
+{{end}}{{end}}{{if $.Features.Sequences}}
+{{range .Methods}}{{if .Results}}	charlatan{{.Name}}Count  int                     // the number of calls of {{.Name}}, see Set{{.Name}}StubOnCall
+	charlatan{{.Name}}OnCall map[int]{{.ResultsName}} // the results of {{.Name}} configured for a call number
+{{end}}{{end}}{{end}}{{if $.Features.Strict}}
+	strict       bool                                       // true if the expectations are verified when the test ends
+	expectations []func(errorf func(string, ...interface{})) // report the unmet expectations of a strict fake
+	hookExpectations map[string]func(errorf func(string, ...interface{})) // report the unmet expectations of the hooks configured by the Set* methods, by method name
+{{end}}{{if $.Features.Sync}}
+	mutex sync.RWMutex // guards the hooks and calls when the fake is shared by goroutines
 {{end}}}
 
-// NewFake{{.Name}}DefaultPanic returns an instance of Fake{{.Name}} with all hooks configured to panic
-func NewFake{{.Name}}DefaultPanic() *Fake{{.Name}} {
-	return &Fake{{.Name}}{
-{{range .Methods}}		{{.Name}}Hook: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
+var _ {{.QualifiedName}} = (*{{.FakeName}})(nil)
+{{if $.Features.Constructors}}
+// {{.ConstructorName "DefaultPanic"}} returns an instance of {{.FakeName}} with all hooks configured to panic
+func {{.ConstructorName "DefaultPanic"}}() *{{.FakeName}} {
+	return &{{.FakeName}}{
+{{range .Methods}}		{{.HookName}}: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
 			panic("Unexpected call to {{.Interface}}.{{.Name}}")
 		},
 {{end}}
 	}
 }
 
-// NewFake{{$i.Name}}DefaultFatal returns an instance of Fake{{$i.Name}} with all hooks configured to call t.Fatal
-{{with $sym := gensym}}func NewFake{{$i.Name}}DefaultFatal(t{{$sym}} {{$i.Name}}TestingT) *Fake{{$i.Name}} {
-	return &Fake{{$i.Name}}{
-{{range $i.Methods}}		{{.Name}}Hook: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
+// {{$i.ConstructorName "DefaultFatal"}} returns an instance of {{$i.FakeName}} with all hooks configured to call t.Fatal
+{{with $sym := gensym}}func {{$i.ConstructorName "DefaultFatal"}}(t{{$sym}} {{$.TestingT.Type $i.Name}}) *{{$i.FakeName}} {
+	return &{{$i.FakeName}}{
+{{range $i.Methods}}		{{.HookName}}: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
 			t{{$sym}}.Fatal("Unexpected call to {{.Interface}}.{{.Name}}")
 			return
 		},
@@ -101,10 +196,10 @@ func NewFake{{.Name}}DefaultPanic() *Fake{{.Name}} {
 	}
 }{{end}}
 
-// NewFake{{$i.Name}}DefaultError returns an instance of Fake{{$i.Name}} with all hooks configured to call t.Error
-{{with $sym := gensym}}func NewFake{{$i.Name}}DefaultError(t{{$sym}} {{$i.Name}}TestingT) *Fake{{$i.Name}} {
-	return &Fake{{$i.Name}}{
-{{range $i.Methods}}		{{.Name}}Hook: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
+// {{$i.ConstructorName "DefaultError"}} returns an instance of {{$i.FakeName}} with all hooks configured to call t.Error
+{{with $sym := gensym}}func {{$i.ConstructorName "DefaultError"}}(t{{$sym}} {{$.TestingT.Type $i.Name}}) *{{$i.FakeName}} {
+	return &{{$i.FakeName}}{
+{{range $i.Methods}}		{{.HookName}}: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
 			t{{$sym}}.Error("Unexpected call to {{.Interface}}.{{.Name}}")
 			return
 		},
@@ -112,44 +207,407 @@ func NewFake{{.Name}}DefaultPanic() *Fake{{.Name}} {
 	}
 }{{end}}
 
-func (f *Fake{{.Name}}) Reset() {
-{{range .Methods}} f.{{.Name}}Calls = []*{{.Interface}}{{.Name}}Invocation{}
-{{end}}}
+// {{$i.ConstructorName "DefaultZero"}} returns an instance of {{$i.FakeName}} with all hooks configured to return zero values
+func {{$i.ConstructorName "DefaultZero"}}() *{{$i.FakeName}} {
+	return &{{$i.FakeName}}{
+{{range $i.Methods}}		{{.HookName}}: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
+			return
+		},
+{{end}}
+	}
+}
+
+// {{$i.ConstructorName "DefaultErr"}} returns an instance of {{$i.FakeName}} with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+{{with $sym := gensym}}func {{$i.ConstructorName "DefaultErr"}}(err{{$sym}} error) *{{$i.FakeName}} {
+	return &{{$i.FakeName}}{
+{{range $i.Methods}}		{{.HookName}}: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
+			return{{if .ReturnsError}} {{.ErrorResults (print "err" $sym)}}{{end}}
+		},
+{{end}}
+	}
+}{{end}}
+
+// {{$i.ConstructorName "Spy"}} returns an instance of {{$i.FakeName}} with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+{{with $sym := gensym}}func {{$i.ConstructorName "Spy"}}(real{{$sym}} {{$i.QualifiedName}}) *{{$i.FakeName}} {
+	return &{{$i.FakeName}}{
+{{range $i.Methods}}		{{.HookName}}: real{{$sym}}.{{.Name}},
+{{end}}
+	}
+}{{end}}{{end}}{{/* end if $.Features.Constructors */}}
+{{if $.Features.Strict}}
+// {{$i.ConstructorName "Strict"}} returns an instance of {{$i.FakeName}} that fails the test when it ends if a method was
+// called without a configured hook, or if a stub or invocation configured on the fake was never used
+{{with $sym := gensym}}func {{$i.ConstructorName "Strict"}}(t{{$sym}} {{$.TestingT.CleanupType $i.Name}}) *{{$i.FakeName}} {
+	f{{$sym}} := &{{$i.FakeName}}{strict: true}
+{{range $m := $i.Methods}}{{with $u := gensym}}
+	var unexpected{{$u}} int
+	f{{$sym}}.{{$m.HookName}} = func({{$m.ParametersSignature}}) ({{$m.ResultsDeclaration}}) {
+{{if $.Features.Sync}}		f{{$sym}}.mutex.Lock()
+{{end}}		unexpected{{$u}}++
+{{if $.Features.Sync}}		f{{$sym}}.mutex.Unlock()
+{{end}}		return
+	}
+	f{{$sym}}.charlatanExpect(func(errorf{{$u}} func(string, ...interface{})) {
+		if unexpected{{$u}} != 0 {
+			errorf{{$u}}("{{$m.Interface}}.{{$m.Name}} called %d times without a configured hook", unexpected{{$u}})
+		}
+	})
+{{end}}{{end}}
+	t{{$sym}}.Cleanup(func() {
+{{if $.Features.Sync}}		f{{$sym}}.mutex.RLock()
+		defer f{{$sym}}.mutex.RUnlock()
+{{end}}		for _, expectation{{$sym}} := range f{{$sym}}.expectations {
+			expectation{{$sym}}(t{{$sym}}.Errorf)
+		}
+{{range $m := $i.Methods}}		if expectation{{$sym}} := f{{$sym}}.hookExpectations["{{$m.Name}}"]; expectation{{$sym}} != nil {
+			expectation{{$sym}}(t{{$sym}}.Errorf)
+		}
+{{end}}	})
+
+	return f{{$sym}}
+}{{end}}
+
+// charlatanExpect registers an expectation of a strict fake, verified when the test ends{{if $.Features.Sync}}.  The mutex must be held.{{end}}
+func (f *{{.FakeName}}) charlatanExpect(expectation func(errorf func(string, ...interface{}))) {
+	if f.strict {
+		f.expectations = append(f.expectations, expectation)
+	}
+}
+
+// charlatanExpectHook replaces the expectation of the hook of the method of a strict fake, which is no longer
+// verified once the hook is replaced, a nil expectation expects nothing{{if $.Features.Sync}}.  The mutex must be held.{{end}}
+func (f *{{.FakeName}}) charlatanExpectHook(method string, expectation func(errorf func(string, ...interface{}))) {
+	if !f.strict {
+		return
+	}
+	if f.hookExpectations == nil {
+		f.hookExpectations = make(map[string]func(errorf func(string, ...interface{})))
+	}
+	f.hookExpectations[method] = expectation
+}{{end}}{{/* end if $.Features.Strict */}}
+{{if $.Features.Cassettes}}
+// {{$i.ConstructorName "Record"}} returns an instance of {{$i.FakeName}} with all hooks configured to call the given
+// implementation.  When the test ends the calls are written to the cassette file at path, see {{$i.ConstructorName "Replay"}}.
+{{with $sym := gensym}}func {{$i.ConstructorName "Record"}}(t{{$sym}} {{$.TestingT.CleanupType $i.Name}}, path{{$sym}} string, real{{$sym}} {{$i.QualifiedName}}) *{{$i.FakeName}} {
+	f{{$sym}} := &{{$i.FakeName}}{}
+	cassette{{$sym}} := []json.Marshaler{}
+{{range $m := $i.Methods}}
+	f{{$sym}}.{{$m.HookName}} = func({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
+		{{if $m.Results}}{{$m.ResultsReference}} = {{end}}real{{$sym}}.{{$m.Name}}({{$m.ParametersReference}})
+		invocation{{$sym}} := new({{$m.InvocationName}})
+{{range $m.Parameters}}		invocation{{$sym}}.Parameters.{{.TitleCase}} = {{.Name}}
+{{end}}{{range $m.Results}}		invocation{{$sym}}.Results.{{.TitleCase}} = {{.Name}}
+{{end}}{{if $.Features.Sync}}		f{{$sym}}.mutex.Lock()
+{{end}}		cassette{{$sym}} = append(cassette{{$sym}}, invocation{{$sym}})
+{{if $.Features.Sync}}		f{{$sym}}.mutex.Unlock()
+{{end}}
+		return
+	}
+{{end}}
+	t{{$sym}}.Cleanup(func() {
+{{if $.Features.Sync}}		f{{$sym}}.mutex.RLock()
+		defer f{{$sym}}.mutex.RUnlock()
+{{end}}		data{{$sym}}, err{{$sym}} := json.MarshalIndent(cassette{{$sym}}, "", "\t")
+		if err{{$sym}} == nil {
+			err{{$sym}} = os.WriteFile(path{{$sym}}, append(data{{$sym}}, '\n'), 0644)
+		}
+		if err{{$sym}} != nil {
+			t{{$sym}}.Errorf("cannot record cassette %s: %s", path{{$sym}}, err{{$sym}})
+		}
+	})
+
+	return f{{$sym}}
+}{{end}}
+
+// {{$i.ConstructorName "Replay"}} returns an instance of {{$i.FakeName}} with all hooks configured to return the results
+// of the calls recorded in the cassette file at path by {{$i.ConstructorName "Record"}}.  Each recorded call is
+// replayed once, to a call with the same parameters, other calls fail the test.
+{{with $sym := gensym}}func {{$i.ConstructorName "Replay"}}(t{{$sym}} {{$.TestingT.Type $i.Name}}, path{{$sym}} string) *{{$i.FakeName}} {
+	t{{$sym}}.Helper()
+	f{{$sym}} := &{{$i.FakeName}}{}
+	data{{$sym}}, err{{$sym}} := os.ReadFile(path{{$sym}})
+	if err{{$sym}} != nil {
+		t{{$sym}}.Fatal("cannot replay cassette:", err{{$sym}})
+		return f{{$sym}}
+	}
+	var entries{{$sym}} []json.RawMessage
+	if err{{$sym}} := json.Unmarshal(data{{$sym}}, &entries{{$sym}}); err{{$sym}} != nil {
+		t{{$sym}}.Fatal("cannot replay cassette "+path{{$sym}}+":", err{{$sym}})
+		return f{{$sym}}
+	}
+
+{{range $m := $i.Methods}}	var calls{{$m.Name}}{{$sym}} []*{{$m.InvocationName}}
+	var keys{{$m.Name}}{{$sym}} []string
+{{end}}	for _, entry{{$sym}} := range entries{{$sym}} {
+		var call{{$sym}} struct {
+			Interface string {{jsonTag "interface"}}
+			Method    string {{jsonTag "method"}}
+		}
+		if err{{$sym}} := json.Unmarshal(entry{{$sym}}, &call{{$sym}}); err{{$sym}} != nil {
+			t{{$sym}}.Fatal("cannot replay cassette "+path{{$sym}}+":", err{{$sym}})
+			return f{{$sym}}
+		}
+		if call{{$sym}}.Interface != "{{$i.Name}}" {
+			continue
+		}
+
+		switch call{{$sym}}.Method {
+{{range $m := $i.Methods}}		case "{{$m.Name}}":
+			invocation{{$sym}} := new({{$m.InvocationName}})
+			err{{$sym}} := json.Unmarshal(entry{{$sym}}, invocation{{$sym}})
+			var key{{$sym}} string
+			if err{{$sym}} == nil {
+				key{{$sym}}, err{{$sym}} = invocation{{$sym}}.charlatanCassetteKey()
+			}
+			if err{{$sym}} != nil {
+				t{{$sym}}.Fatal("cannot replay cassette "+path{{$sym}}+":", err{{$sym}})
+				return f{{$sym}}
+			}
+			calls{{$m.Name}}{{$sym}} = append(calls{{$m.Name}}{{$sym}}, invocation{{$sym}})
+			keys{{$m.Name}}{{$sym}} = append(keys{{$m.Name}}{{$sym}}, key{{$sym}})
+{{end}}		default:
+			t{{$sym}}.Fatal("cannot replay cassette "+path{{$sym}}+": unknown method {{$i.Name}}."+call{{$sym}}.Method)
+			return f{{$sym}}
+		}
+	}
+{{range $m := $i.Methods}}
+	f{{$sym}}.{{$m.HookName}} = func({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
+		call{{$sym}} := new({{$m.InvocationName}})
+{{range $m.Parameters}}		call{{$sym}}.Parameters.{{.TitleCase}} = {{.Name}}
+{{end}}		key{{$sym}}, err{{$sym}} := call{{$sym}}.charlatanCassetteKey()
+		if err{{$sym}} != nil {
+			t{{$sym}}.Errorf("{{$m.Interface}}.{{$m.Name}}() called with parameters that cannot be encoded: %s", err{{$sym}})
+			return
+		}
+
+{{if $.Features.Sync}}		f{{$sym}}.mutex.Lock()
+{{end}}		for i{{$sym}}, recorded{{$sym}} := range calls{{$m.Name}}{{$sym}} {
+			if recorded{{$sym}} != nil && keys{{$m.Name}}{{$sym}}[i{{$sym}}] == key{{$sym}} {
+				calls{{$m.Name}}{{$sym}}[i{{$sym}}] = nil
+{{if $.Features.Sync}}				f{{$sym}}.mutex.Unlock()
+{{end}}{{range $m.Results}}				{{.Name}} = recorded{{$sym}}.Results.{{.TitleCase}}
+{{end}}
+				return
+			}
+		}
+{{if $.Features.Sync}}		f{{$sym}}.mutex.Unlock()
+{{end}}
+		t{{$sym}}.Errorf("{{$m.Interface}}.{{$m.Name}}() called with %s but no such call is left in cassette %s", key{{$sym}}, path{{$sym}})
+		return
+	}
+{{end}}
+	return f{{$sym}}
+}{{end}}{{end}}{{/* end if $.Features.Cassettes */}}
+{{if $.Features.Calls}}
+func (f *{{.FakeName}}) Reset() {
+{{if $.Features.Sync}}	f.mutex.Lock()
+	defer f.mutex.Unlock()
+{{end}}{{range .Methods}} f.{{.CallsName}} = []*{{.InvocationName}}{}
+{{end}}{{if $.Features.Sequences}}{{range .Methods}}{{if .Results}}	f.charlatan{{.Name}}Count = 0
+{{end}}{{end}}{{end}}}{{end}}
+{{if and $.Features.Stubs .ReturnsError}}
+// FailAll configures every method of {{.Name}} whose last result is an error to return err and zero values, see
+// Set*Error.  A strict fake does not require the methods to be called.
+func (f *{{.FakeName}}) FailAll(err error) {
+{{range .Methods}}{{if .ReturnsError}}	f.Set{{.Name}}Error(err)
+{{end}}{{end}}}
+{{end}}
 
 {{range $m := .Methods}}
-{{with $sym := gensym}}func (f{{$sym}} *Fake{{$m.Interface}}) {{$m.Name}}({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
-	if f{{$sym}}.{{$m.Name}}Hook == nil {
-		panic("{{$m.Interface}}.{{$m.Name}}() called but Fake{{$m.Interface}}.{{$m.Name}}Hook is nil")
+{{$m.DocComment}}{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) {{$m.Name}}({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.Lock()
+	hook{{$sym}} := f{{$sym}}.{{$m.HookName}}
+{{if and $.Features.Sequences $m.Results}}	f{{$sym}}.charlatan{{$m.Name}}Count++
+	results{{$sym}}, onCall{{$sym}} := f{{$sym}}.charlatan{{$m.Name}}OnCall[f{{$sym}}.charlatan{{$m.Name}}Count]
+	if hook{{$sym}} == nil && !onCall{{$sym}} {
+{{else}}	if hook{{$sym}} == nil {
+{{end}}		f{{$sym}}.mutex.Unlock()
+		panic("{{$m.Interface}}.{{$m.Name}}() called but {{$m.FakeName}}.{{$m.HookName}} is nil")
 	}
 
-	invocation{{$sym}} := new({{$m.Interface}}{{$m.Name}}Invocation)
-	f{{$sym}}.{{$m.Name}}Calls = append(f{{$sym}}.{{$m.Name}}Calls, invocation{{$sym}})
+{{if $.Features.Calls}}
+	invocation{{$sym}} := new({{$m.InvocationName}})
+{{if $.Features.Order}}	invocation{{$sym}}.Sequence = charlatanNextCall()
+{{end}}	f{{$sym}}.{{$m.CallsName}} = append(f{{$sym}}.{{$m.CallsName}}, invocation{{$sym}})
 
 {{if $m.Parameters}}{{range $m.Parameters}} invocation{{$sym}}.Parameters.{{.TitleCase}} = {{.Name}}
-{{end}}{{end}}
-{{if $m.Results}} {{$m.ResultsReference}} = f{{$sym}}.{{$m.Name}}Hook({{$m.ParametersReference}})
-{{else}} f{{$sym}}.{{$m.Name}}Hook({{$m.ParametersReference}})
+{{end}}{{end}}{{end}}
+	f{{$sym}}.mutex.Unlock()
+
+{{if and $.Features.Sequences $m.Results}}	if onCall{{$sym}} {
+{{range $m.Results}}		{{.Name}} = results{{$sym}}.{{.TitleCase}}
+{{end}}	} else {
+		{{$m.ResultsReference}} = hook{{$sym}}({{$m.ParametersReference}})
+	}
+{{else if $m.Results}} {{$m.ResultsReference}} = hook{{$sym}}({{$m.ParametersReference}})
+{{else}} hook{{$sym}}({{$m.ParametersReference}})
+{{end}}
+{{if and $.Features.Calls $m.Results}}
+	f{{$sym}}.mutex.Lock()
+	{{range $m.Results}}invocation{{$sym}}.Results.{{.TitleCase}} = {{.Name}}
+	{{end}}f{{$sym}}.mutex.Unlock()
 {{end}}
-{{if $m.Results}}{{range $m.Results}}invocation{{$sym}}.Results.{{.TitleCase}} = {{.Name}}
-{{end}}{{end}}
 
 	return
-}{{end}}
-{{if .Results}}
+{{else}}{{if and $.Features.Sequences $m.Results}}	f{{$sym}}.charlatan{{$m.Name}}Count++
+	results{{$sym}}, onCall{{$sym}} := f{{$sym}}.charlatan{{$m.Name}}OnCall[f{{$sym}}.charlatan{{$m.Name}}Count]
+	if f{{$sym}}.{{$m.HookName}} == nil && !onCall{{$sym}} {
+{{else}}	if f{{$sym}}.{{$m.HookName}} == nil {
+{{end}}		panic("{{$m.Interface}}.{{$m.Name}}() called but {{$m.FakeName}}.{{$m.HookName}} is nil")
+	}
+
+{{if $.Features.Calls}}
+	invocation{{$sym}} := new({{$m.InvocationName}})
+{{if $.Features.Order}}	invocation{{$sym}}.Sequence = charlatanNextCall()
+{{end}}	f{{$sym}}.{{$m.CallsName}} = append(f{{$sym}}.{{$m.CallsName}}, invocation{{$sym}})
+
+{{if $m.Parameters}}{{range $m.Parameters}} invocation{{$sym}}.Parameters.{{.TitleCase}} = {{.Name}}
+{{end}}{{end}}{{end}}
+{{if and $.Features.Sequences $m.Results}}	if onCall{{$sym}} {
+{{range $m.Results}}		{{.Name}} = results{{$sym}}.{{.TitleCase}}
+{{end}}	} else {
+		{{$m.ResultsReference}} = f{{$sym}}.{{$m.HookName}}({{$m.ParametersReference}})
+	}
+{{else if $m.Results}} {{$m.ResultsReference}} = f{{$sym}}.{{$m.HookName}}({{$m.ParametersReference}})
+{{else}} f{{$sym}}.{{$m.HookName}}({{$m.ParametersReference}})
+{{end}}
+{{if $.Features.Calls}}{{if $m.Results}}{{range $m.Results}}invocation{{$sym}}.Results.{{.TitleCase}} = {{.Name}}
+{{end}}{{end}}{{end}}
+
+	return
+{{end}}{{/* end if $.Features.Sync */}}}{{end}}
+{{if and $.Features.Calls $.Features.Sync}}
+// {{.CallsName}}Snapshot returns a copy of the calls of {{.FakeName}}.{{.Name}}, which can be inspected while the fake is in use
+{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) {{$m.CallsName}}Snapshot() []*{{$m.InvocationName}} {
+	f{{$sym}}.mutex.RLock()
+	defer f{{$sym}}.mutex.RUnlock()
+
+	calls{{$sym}} := make([]*{{$m.InvocationName}}, len(f{{$sym}}.{{$m.CallsName}}))
+	for i{{$sym}}, call{{$sym}} := range f{{$sym}}.{{$m.CallsName}} {
+		snapshot{{$sym}} := *call{{$sym}}
+		calls{{$sym}}[i{{$sym}}] = &snapshot{{$sym}}
+	}
+
+	return calls{{$sym}}
+}{{end}}{{end}}{{/* end if and $.Features.Calls $.Features.Sync */}}
+{{if and $.Features.Stubs .Results}}
 // Set{{.Name}}Stub configures {{.Interface}}.{{.Name}} to always return the given values
-{{with $sym := gensym}}func (f{{$sym}} *Fake{{$m.Interface}}) Set{{$m.Name}}Stub({{$m.ResultsDeclaration}}) {
-	f{{$sym}}.{{$m.Name}}Hook = func({{$m.ParametersSignature}}) ({{$m.ResultsSignature}}) {
-		return {{range $idx, $r := $m.Results}}{{if $idx}}, {{end}}{{$r.Name}}{{end}}
+{{.DeprecatedComment}}{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) Set{{$m.Name}}Stub({{$m.ResultsDeclaration}}) {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.Lock()
+	defer f{{$sym}}.mutex.Unlock()
+{{end}}{{if $.Features.Strict}}	var used{{$sym}} bool
+	f{{$sym}}.charlatanExpectHook("{{$m.Name}}", func(errorf{{$sym}} func(string, ...interface{})) {
+		if !used{{$sym}} {
+			errorf{{$sym}}("{{$m.FakeName}}.Set{{$m.Name}}Stub configured but {{$m.Interface}}.{{$m.Name}} not called")
+		}
+	})
+{{end}}	f{{$sym}}.{{$m.HookName}} = func({{$m.ParametersSignature}}) ({{$m.ResultsSignature}}) {
+{{if $.Features.Strict}}{{if $.Features.Sync}}		f{{$sym}}.mutex.Lock()
+{{end}}		used{{$sym}} = true
+{{if $.Features.Sync}}		f{{$sym}}.mutex.Unlock()
+{{end}}{{end}}		return {{range $idx, $r := $m.Results}}{{if $idx}}, {{end}}{{$r.Name}}{{end}}
+	}
+}{{end}}
+{{if .ReturnsError}}
+// Set{{.Name}}Error configures {{.Interface}}.{{.Name}} to always return err and zero values.  Unlike a stub, a strict
+// fake does not require the method to be called.
+{{.DeprecatedComment}}{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) Set{{$m.Name}}Error(err{{$sym}} error) {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.Lock()
+	defer f{{$sym}}.mutex.Unlock()
+{{end}}{{if $.Features.Strict}}	f{{$sym}}.charlatanExpectHook("{{$m.Name}}", nil)
+{{end}}	f{{$sym}}.{{$m.HookName}} = func({{$m.ParametersSignature}}) ({{$m.ResultsSignature}}) {
+		return {{$m.ErrorResults (print "err" $sym)}}
+	}
+}{{end}}
+{{end}}{{end}}{{/* end if and $.Features.Stubs .Results */}}
+{{if and $.Features.Sequences .Results}}
+// {{.ResultsName}} holds the results of a call of {{.FakeName}}.{{.Name}}, see Set{{.Name}}StubSequence
+{{.DeprecatedComment}}type {{.ResultsName}} struct {
+{{range .Results}}	{{.FieldFormat}}
+{{end}}
+}
+
+// Set{{.Name}}StubSequence configures {{.Interface}}.{{.Name}} to return the given results in order, one per call.
+// Once the results are exhausted the last results are returned again.
+{{.DeprecatedComment}}func (f *{{.FakeName}}) Set{{.Name}}StubSequence(results ...{{.ResultsName}}) {
+	f.Set{{.Name}}StubSequenceExhausted(CharlatanRepeatLast(), results...)
+}
+
+// Set{{.Name}}StubSequenceExhausted configures {{.Interface}}.{{.Name}} to return the given results in order, one
+// per call.  Once the results are exhausted each call is reported to exhausted, then the last results are returned.
+{{.DeprecatedComment}}{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) Set{{$m.Name}}StubSequenceExhausted(exhausted{{$sym}} CharlatanExhausted, results{{$sym}} ...{{$m.ResultsName}}) {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.Lock()
+	defer f{{$sym}}.mutex.Unlock()
+{{end}}	var calls{{$sym}} int
+{{if $.Features.Strict}}	f{{$sym}}.charlatanExpectHook("{{$m.Name}}", func(errorf{{$sym}} func(string, ...interface{})) {
+		if calls{{$sym}} < len(results{{$sym}}) {
+			errorf{{$sym}}("{{$m.FakeName}}.Set{{$m.Name}}StubSequence configured with %d results but {{$m.Interface}}.{{$m.Name}} called %d times", len(results{{$sym}}), calls{{$sym}})
+		}
+	})
+{{end}}	f{{$sym}}.{{$m.HookName}} = func({{$m.ParametersSignature}}) ({{$m.ResultsDeclaration}}) {
+{{if $.Features.Sync}}		f{{$sym}}.mutex.Lock()
+{{end}}		call{{$sym}} := calls{{$sym}}
+		calls{{$sym}}++
+{{if $.Features.Sync}}		f{{$sym}}.mutex.Unlock()
+{{end}}		if call{{$sym}} >= len(results{{$sym}}) {
+			exhausted{{$sym}}("{{$m.Interface}}.{{$m.Name}}", len(results{{$sym}}))
+			if len(results{{$sym}}) == 0 {
+				return
+			}
+			call{{$sym}} = len(results{{$sym}}) - 1
+		}
+
+		{{range $m.Results}}{{.Name}} = results{{$sym}}[call{{$sym}}].{{.TitleCase}}
+		{{end}}
+		return
 	}
-}{{end}}{{end}}{{/* end if .Results */}}
-{{if and .Parameters .Results}}
+}{{end}}
+
+// Set{{.Name}}StubOnCall configures {{.Interface}}.{{.Name}} to return the given values on the nth call, counting from 1
+// since the fake was created{{if $.Features.Calls}} or Reset{{end}}.  Other calls are passed to the hook.
+{{.DeprecatedComment}}{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) Set{{$m.Name}}StubOnCall(n{{$sym}} int, {{$m.ResultsDeclaration}}) {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.Lock()
+	defer f{{$sym}}.mutex.Unlock()
+{{end}}	if f{{$sym}}.charlatan{{$m.Name}}OnCall == nil {
+		f{{$sym}}.charlatan{{$m.Name}}OnCall = make(map[int]{{$m.ResultsName}})
+	}
+	f{{$sym}}.charlatan{{$m.Name}}OnCall[n{{$sym}}] = {{$m.ResultsName}}{ {{- range $idx, $r := $m.Results}}{{if $idx}}, {{end}}{{$r.TitleCase}}: {{$r.Name}}{{end -}} }
+{{if $.Features.Strict}}	f{{$sym}}.charlatanExpect(func(errorf{{$sym}} func(string, ...interface{})) {
+		if f{{$sym}}.charlatan{{$m.Name}}Count < n{{$sym}} {
+			errorf{{$sym}}("{{$m.FakeName}}.Set{{$m.Name}}StubOnCall configured for call %d but {{$m.Interface}}.{{$m.Name}} called %d times", n{{$sym}}, f{{$sym}}.charlatan{{$m.Name}}Count)
+		}
+	})
+{{end}}}{{end}}
+{{if .ReturnsError}}
+// Set{{.Name}}ErrorOnCall configures {{.Interface}}.{{.Name}} to return err and zero values on the nth call, counting
+// from 1 since the fake was created{{if $.Features.Calls}} or Reset{{end}}.  Other calls are passed to the hook.
+{{.DeprecatedComment}}func (f *{{.FakeName}}) Set{{.Name}}ErrorOnCall(n int, err error) {
+	f.Set{{.Name}}StubOnCall(n, {{.ErrorResults "err"}})
+}
+{{end}}{{end}}{{/* end if and $.Features.Sequences .Results */}}
+{{if and $.Features.Invocations .Parameters .Results}}
 // Set{{.Name}}Invocation configures {{.Interface}}.{{.Name}} to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-{{with $sym := gensym}}func (f{{$sym}} *Fake{{$m.Interface}}) Set{{$m.Name}}Invocation(calls{{$sym}} []*{{$m.Interface}}{{$m.Name}}Invocation, fallback{{$sym}} func() ({{$m.ResultsSignature}})) {
-	f{{$sym}}.{{$m.Name}}Hook = func({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
-		for _, call{{$sym}} := range calls{{$sym}} {
+{{.DeprecatedComment}}{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) Set{{$m.Name}}Invocation(calls{{$sym}} []*{{$m.InvocationName}}, fallback{{$sym}} func() ({{$m.ResultsSignature}})) {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.Lock()
+	defer f{{$sym}}.mutex.Unlock()
+{{end}}{{if $.Features.Strict}}	matched{{$sym}} := make([]bool, len(calls{{$sym}}))
+	f{{$sym}}.charlatanExpectHook("{{$m.Name}}", func(errorf{{$sym}} func(string, ...interface{})) {
+		for i{{$sym}}, call{{$sym}} := range calls{{$sym}} {
+			if !matched{{$sym}}[i{{$sym}}] {
+				errorf{{$sym}}("{{$m.FakeName}}.Set{{$m.Name}}Invocation configured with %+v but {{$m.Interface}}.{{$m.Name}} not called with those parameters", call{{$sym}}.Parameters)
+			}
+		}
+	})
+{{end}}	f{{$sym}}.{{$m.HookName}} = func({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
+		for {{if $.Features.Strict}}i{{$sym}}{{else}}_{{end}}, call{{$sym}} := range calls{{$sym}} {
 			if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}reflect.DeepEqual(call{{$sym}}.Parameters.{{$p.TitleCase}}, {{$p.Name}}){{end}} {
-				{{range $m.Results}}{{.Name}} = call{{$sym}}.Results.{{.TitleCase}}
+{{if $.Features.Strict}}{{if $.Features.Sync}}				f{{$sym}}.mutex.Lock()
+{{end}}				matched{{$sym}}[i{{$sym}}] = true
+{{if $.Features.Sync}}				f{{$sym}}.mutex.Unlock()
+{{end}}{{end}}				{{range $m.Results}}{{.Name}} = call{{$sym}}.Results.{{.TitleCase}}
 				{{end}}
 				return
 			}
@@ -157,76 +615,129 @@ func (f *Fake{{.Name}}) Reset() {
 
 		return fallback{{$sym}}()
 	}
-}{{end}}{{end}}{{/* end if and .Parameters .Results */}}
+}{{end}}{{end}}{{/* end if and $.Features.Invocations .Parameters .Results */}}
+{{if and $.Features.Invocations $.Features.Matchers .Parameters .Results}}
+// Set{{.Name}}InvocationMatch configures {{.Interface}}.{{.Name}} to return the given results when its parameters
+// are matched by the given matchers, one per parameter.  Other calls are passed to the previously configured hook.
+{{.DeprecatedComment}}{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) Set{{$m.Name}}InvocationMatch({{range $m.Parameters}}{{.Name}} CharlatanMatcher, {{end}}{{$m.ResultsDeclaration}}) {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.Lock()
+	defer f{{$sym}}.mutex.Unlock()
+{{end}}	matchers{{$sym}} := []CharlatanMatcher{ {{- range $idx, $p := $m.Parameters}}{{if $idx}}, {{end}}{{$p.Name}}{{end -}} }
+	previous{{$sym}} := f{{$sym}}.{{$m.HookName}}
+{{if $.Features.Strict}}	var used{{$sym}} bool
+	expected{{$sym}} := f{{$sym}}.hookExpectations["{{$m.Name}}"]
+	f{{$sym}}.charlatanExpectHook("{{$m.Name}}", func(errorf{{$sym}} func(string, ...interface{})) {
+		// N.B. - the previous hook still receives the unmatched calls
+		if expected{{$sym}} != nil {
+			expected{{$sym}}(errorf{{$sym}})
+		}
+		if !used{{$sym}} {
+			errorf{{$sym}}("{{$m.FakeName}}.Set{{$m.Name}}InvocationMatch configured but {{$m.Interface}}.{{$m.Name}} not called with matching parameters")
+		}
+	})
+{{end}}	f{{$sym}}.{{$m.HookName}} = func({{$m.ParametersDeclaration}}) ({{$m.ResultsSignature}}) {
+		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}matchers{{$sym}}[{{$idx}}].Match({{$p.Name}}){{end}} {
+{{if $.Features.Strict}}{{if $.Features.Sync}}			f{{$sym}}.mutex.Lock()
+{{end}}			used{{$sym}} = true
+{{if $.Features.Sync}}			f{{$sym}}.mutex.Unlock()
+{{end}}{{end}}			return {{range $idx, $r := $m.Results}}{{if $idx}}, {{end}}{{$r.Name}}{{end}}
+		}
+		if previous{{$sym}} == nil {
+			panic("{{$m.Interface}}.{{$m.Name}}() called with unmatched parameters but {{$m.FakeName}}.{{$m.HookName}} has no previous hook")
+		}
 
-// {{.Name}}Called returns true if Fake{{.Interface}}.{{.Name}} was called
-func (f *Fake{{.Interface}}) {{.Name}}Called() bool {
-	return len(f.{{.Name}}Calls) != 0
-}
+		return previous{{$sym}}({{$m.ParametersReference}})
+	}
+}{{end}}{{end}}{{/* end if and $.Features.Invocations $.Features.Matchers .Parameters .Results */}}
 
-// Assert{{.Name}}Called calls t.Error if Fake{{.Interface}}.{{.Name}} was not called
-func (f *Fake{{.Interface}}) Assert{{.Name}}Called(t {{.Interface}}TestingT) {
+{{if $.Features.Called}}// {{.Name}}Called returns true if {{.FakeName}}.{{.Name}} was called
+func (f *{{.FakeName}}) {{.Name}}Called() bool {
+{{if $.Features.Sync}}	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+{{end}}	return len(f.{{.CallsName}}) != 0
+}{{end}}
+
+{{if $.Features.Assert}}// Assert{{.Name}}Called calls t.Error if {{.FakeName}}.{{.Name}} was not called
+func (f *{{.FakeName}}) Assert{{.Name}}Called(t {{$.TestingT.Type .Interface}}) {
 	t.Helper()
-	if len(f.{{.Name}}Calls) == 0 {
-		t.Error("Fake{{.Interface}}.{{.Name}} not called, expected at least one")
+{{if $.Features.Sync}}	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+{{end}}	if len(f.{{.CallsName}}) == 0 {
+		t.Error("{{.FakeName}}.{{.Name}} not called, expected at least one")
 	}
-}
+}{{end}}
 
-// {{.Name}}NotCalled returns true if Fake{{.Interface}}.{{.Name}} was not called
-func (f *Fake{{.Interface}}) {{.Name}}NotCalled() bool {
-	return len(f.{{.Name}}Calls) == 0
-}
+{{if $.Features.Called}}// {{.Name}}NotCalled returns true if {{.FakeName}}.{{.Name}} was not called
+func (f *{{.FakeName}}) {{.Name}}NotCalled() bool {
+{{if $.Features.Sync}}	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+{{end}}	return len(f.{{.CallsName}}) == 0
+}{{end}}
 
-// Assert{{.Name}}NotCalled calls t.Error if Fake{{.Interface}}.{{.Name}} was called
-func (f *Fake{{.Interface}}) Assert{{.Name}}NotCalled(t {{.Interface}}TestingT) {
+{{if $.Features.Assert}}// Assert{{.Name}}NotCalled calls t.Error if {{.FakeName}}.{{.Name}} was called
+func (f *{{.FakeName}}) Assert{{.Name}}NotCalled(t {{$.TestingT.Type .Interface}}) {
 	t.Helper()
-	if len(f.{{.Name}}Calls) != 0 {
-		t.Error("Fake{{.Interface}}.{{.Name}} called, expected none")
+{{if $.Features.Sync}}	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+{{end}}	if len(f.{{.CallsName}}) != 0 {
+		t.Error("{{.FakeName}}.{{.Name}} called, expected none")
 	}
-}
+}{{end}}
 
-// {{.Name}}CalledOnce returns true if Fake{{.Interface}}.{{.Name}} was called exactly once
-func (f *Fake{{.Interface}}) {{.Name}}CalledOnce() bool {
-	return len(f.{{.Name}}Calls) == 1
-}
+{{if $.Features.Called}}// {{.Name}}CalledOnce returns true if {{.FakeName}}.{{.Name}} was called exactly once
+func (f *{{.FakeName}}) {{.Name}}CalledOnce() bool {
+{{if $.Features.Sync}}	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+{{end}}	return len(f.{{.CallsName}}) == 1
+}{{end}}
 
-// Assert{{.Name}}CalledOnce calls t.Error if Fake{{.Interface}}.{{.Name}} was not called exactly once
-func (f *Fake{{.Interface}}) Assert{{.Name}}CalledOnce(t {{.Interface}}TestingT) {
+{{if $.Features.Assert}}// Assert{{.Name}}CalledOnce calls t.Error if {{.FakeName}}.{{.Name}} was not called exactly once
+func (f *{{.FakeName}}) Assert{{.Name}}CalledOnce(t {{$.TestingT.Type .Interface}}) {
 	t.Helper()
-	if len(f.{{.Name}}Calls) != 1 {
-		t.Errorf("Fake{{.Interface}}.{{.Name}} called %d times, expected 1", len(f.{{.Name}}Calls))
+{{if $.Features.Sync}}	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+{{end}}	if len(f.{{.CallsName}}) != 1 {
+		t.Errorf("{{.FakeName}}.{{.Name}} called %d times, expected 1", len(f.{{.CallsName}}))
 	}
-}
+}{{end}}
 
-// {{.Name}}CalledN returns true if Fake{{.Interface}}.{{.Name}} was called at least n times
-func (f *Fake{{.Interface}}) {{.Name}}CalledN(n int) bool {
-	return len(f.{{.Name}}Calls) >= n
-}
+{{if $.Features.Called}}// {{.Name}}CalledN returns true if {{.FakeName}}.{{.Name}} was called at least n times
+func (f *{{.FakeName}}) {{.Name}}CalledN(n int) bool {
+{{if $.Features.Sync}}	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+{{end}}	return len(f.{{.CallsName}}) >= n
+}{{end}}
 
-// Assert{{.Name}}CalledN calls t.Error if Fake{{.Interface}}.{{.Name}} was called less than n times
-func (f *Fake{{.Interface}}) Assert{{.Name}}CalledN(t {{.Interface}}TestingT, n int) {
+{{if $.Features.Assert}}// Assert{{.Name}}CalledN calls t.Error if {{.FakeName}}.{{.Name}} was called less than n times
+func (f *{{.FakeName}}) Assert{{.Name}}CalledN(t {{$.TestingT.Type .Interface}}, n int) {
 	t.Helper()
-	if len(f.{{.Name}}Calls) < n {
-		t.Errorf("Fake{{.Interface}}.{{.Name}} called %d times, expected >= %d", len(f.{{.Name}}Calls), n)
+{{if $.Features.Sync}}	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+{{end}}	if len(f.{{.CallsName}}) < n {
+		t.Errorf("{{.FakeName}}.{{.Name}} called %d times, expected >= %d", len(f.{{.CallsName}}), n)
 	}
-}
+}{{end}}
 
-{{if .Parameters}}// {{.Name}}CalledWith returns true if Fake{{.Interface}}.{{.Name}} was called with the given values
-{{with $sym := gensym}}func (f{{$sym}} *Fake{{$m.Interface}}) {{$m.Name}}CalledWith({{$m.ParametersDeclaration}}) bool {
-	for _, call{{$sym}} := range f{{$sym}}.{{$m.Name}}Calls {
+{{if .Parameters}}{{if $.Features.Called}}// {{.Name}}CalledWith returns true if {{.FakeName}}.{{.Name}} was called with the given values
+{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) {{$m.Name}}CalledWith({{$m.ParametersDeclaration}}) bool {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.RLock()
+	defer f{{$sym}}.mutex.RUnlock()
+{{end}}	for _, call{{$sym}} := range f{{$sym}}.{{$m.CallsName}} {
 		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}reflect.DeepEqual(call{{$sym}}.Parameters.{{$p.TitleCase}}, {{$p.Name}}){{end}} {
 			return true
 		}
 	}
 
 	return false
-}{{end}}
+}{{end}}{{end}}
 
-// Assert{{.Name}}CalledWith calls t.Error if Fake{{.Interface}}.{{.Name}} was not called with the given values
-{{with $sym := gensym}}func (f{{$sym}} *Fake{{$m.Interface}}) Assert{{$m.Name}}CalledWith(t {{$m.Interface}}TestingT, {{$m.ParametersDeclaration}}) {
+{{if $.Features.Assert}}// Assert{{.Name}}CalledWith calls t.Error if {{.FakeName}}.{{.Name}} was not called with the given values
+{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) Assert{{$m.Name}}CalledWith(t {{$.TestingT.Type $m.Interface}}, {{$m.ParametersDeclaration}}) {
 	t.Helper()
-	var found{{$sym}} bool
-	for _, call{{$sym}} := range f{{$sym}}.{{$m.Name}}Calls {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.RLock()
+	defer f{{$sym}}.mutex.RUnlock()
+{{end}}	var found{{$sym}} bool
+	for _, call{{$sym}} := range f{{$sym}}.{{$m.CallsName}} {
 		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}reflect.DeepEqual(call{{$sym}}.Parameters.{{$p.TitleCase}}, {{$p.Name}}){{end}} {
 			found{{$sym}} = true
 			break
@@ -234,40 +745,73 @@ func (f *Fake{{.Interface}}) Assert{{.Name}}CalledN(t {{.Interface}}TestingT, n
 	}
 
 	if !found{{$sym}} {
-		t.Error("Fake{{$m.Interface}}.{{$m.Name}} not called with expected parameters")
+		t.Error("{{$m.FakeName}}.{{$m.Name}} not called with expected parameters")
+	}
+}{{end}}{{end}}
+{{if and $.Features.Called $.Features.Matchers}}
+// {{.Name}}CalledWithMatch returns true if {{.FakeName}}.{{.Name}} was called with parameters matched by the given matchers, one per parameter
+{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) {{$m.Name}}CalledWithMatch({{range $idx, $p := $m.Parameters}}{{if $idx}}, {{end}}{{$p.Name}} CharlatanMatcher{{end}}) bool {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.RLock()
+	defer f{{$sym}}.mutex.RUnlock()
+{{end}}	for _, call{{$sym}} := range f{{$sym}}.{{$m.CallsName}} {
+		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$p.Name}}.Match(call{{$sym}}.Parameters.{{$p.TitleCase}}){{end}} {
+			return true
+		}
+	}
+
+	return false
+}{{end}}{{end}}
+{{if and $.Features.Assert $.Features.Matchers}}
+// Assert{{.Name}}CalledWithMatch calls t.Error if {{.FakeName}}.{{.Name}} was not called with parameters matched by the given matchers, one per parameter
+{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) Assert{{$m.Name}}CalledWithMatch(t {{$.TestingT.Type $m.Interface}}, {{range $idx, $p := $m.Parameters}}{{if $idx}}, {{end}}{{$p.Name}} CharlatanMatcher{{end}}) {
+	t.Helper()
+{{if $.Features.Sync}}	f{{$sym}}.mutex.RLock()
+	defer f{{$sym}}.mutex.RUnlock()
+{{end}}	for _, call{{$sym}} := range f{{$sym}}.{{$m.CallsName}} {
+		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$p.Name}}.Match(call{{$sym}}.Parameters.{{$p.TitleCase}}){{end}} {
+			return
+		}
 	}
-}{{end}}
 
-// {{.Name}}CalledOnceWith returns true if Fake{{.Interface}}.{{.Name}} was called exactly once with the given values
-{{with $sym := gensym}}func (f{{$sym}} *Fake{{$m.Interface}}) {{$m.Name}}CalledOnceWith({{$m.ParametersDeclaration}}) bool {
-	var count{{$sym}} int
-	for _, call{{$sym}} := range f{{$sym}}.{{$m.Name}}Calls {
+	t.Error("{{$m.FakeName}}.{{$m.Name}} not called with matching parameters")
+}{{end}}{{end}}
+
+{{if $.Features.Called}}// {{.Name}}CalledOnceWith returns true if {{.FakeName}}.{{.Name}} was called exactly once with the given values
+{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) {{$m.Name}}CalledOnceWith({{$m.ParametersDeclaration}}) bool {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.RLock()
+	defer f{{$sym}}.mutex.RUnlock()
+{{end}}	var count{{$sym}} int
+	for _, call{{$sym}} := range f{{$sym}}.{{$m.CallsName}} {
 		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}reflect.DeepEqual(call{{$sym}}.Parameters.{{$p.TitleCase}}, {{$p.Name}}){{end}} {
 			count{{$sym}}++
 		}
 	}
 
 	return count{{$sym}} == 1
-}{{end}}
+}{{end}}{{end}}
 
-// Assert{{.Name}}CalledOnceWith calls t.Error if Fake{{.Interface}}.{{.Name}} was not called exactly once with the given values
-{{with $sym := gensym}}func (f{{$sym}} *Fake{{$m.Interface}}) Assert{{$m.Name}}CalledOnceWith(t {{$m.Interface}}TestingT, {{$m.ParametersDeclaration}}) {
+{{if $.Features.Assert}}// Assert{{.Name}}CalledOnceWith calls t.Error if {{.FakeName}}.{{.Name}} was not called exactly once with the given values
+{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) Assert{{$m.Name}}CalledOnceWith(t {{$.TestingT.Type $m.Interface}}, {{$m.ParametersDeclaration}}) {
 	t.Helper()
-	var count{{$sym}} int
-	for _, call{{$sym}} := range f{{$sym}}.{{$m.Name}}Calls {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.RLock()
+	defer f{{$sym}}.mutex.RUnlock()
+{{end}}	var count{{$sym}} int
+	for _, call{{$sym}} := range f{{$sym}}.{{$m.CallsName}} {
 		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}reflect.DeepEqual(call{{$sym}}.Parameters.{{$p.TitleCase}}, {{$p.Name}}){{end}} {
 			count{{$sym}}++
 		}
 	}
 
 	if count{{$sym}} != 1 {
-		t.Errorf("Fake{{$m.Interface}}.{{$m.Name}} called %d times with expected parameters, expected one", count{{$sym}})
+		t.Errorf("{{$m.FakeName}}.{{$m.Name}} called %d times with expected parameters, expected one", count{{$sym}})
 	}
-}{{end}}
-{{if len $m.Results }}
-// {{.Name}}ResultsForCall returns the result values for the first call to Fake{{.Interface}}.{{.Name}} with the given values
-{{with $sym := gensym}}func (f{{$sym}} *Fake{{$m.Interface}}) {{$m.Name}}ResultsForCall({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}, found{{$sym}} bool) {
-	for _, call{{$sym}} := range f{{$sym}}.{{$m.Name}}Calls {
+}{{end}}{{end}}
+{{if and $.Features.ResultsForCall (len $m.Results)}}
+// {{.Name}}ResultsForCall returns the result values for the first call to {{.FakeName}}.{{.Name}} with the given values
+{{with $sym := gensym}}func (f{{$sym}} *{{$m.FakeName}}) {{$m.Name}}ResultsForCall({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}, found{{$sym}} bool) {
+{{if $.Features.Sync}}	f{{$sym}}.mutex.RLock()
+	defer f{{$sym}}.mutex.RUnlock()
+{{end}}	for _, call{{$sym}} := range f{{$sym}}.{{$m.CallsName}} {
 		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}reflect.DeepEqual(call{{$sym}}.Parameters.{{$p.TitleCase}}, {{$p.Name}}){{end}} {
 			{{range $m.Results}}{{.Name}} = call{{$sym}}.Results.{{.TitleCase}}
 			{{end}}found{{$sym}} = true
@@ -276,46 +820,382 @@ func (f *Fake{{.Interface}}) Assert{{.Name}}CalledN(t {{.Interface}}TestingT, n
 	}
 
 	return
-}{{end}}{{end}}{{/* end if len $m.Results */}}
+}{{end}}{{end}}{{/* end if and $.Features.ResultsForCall (len $m.Results) */}}
 {{end}}{{/* end if .Parameters */}}
-{{end}}{{/* end range $m := .Methods */}}
+{{end}}{{/* end range $m := .Methods */}}{{if and $.Features.Order $.Features.Assert}}
+// AssertCallOrder calls t.Error if the named methods of {{$i.FakeName}} were not called in the given order, other
+// calls may come between them.  The error reports the order of all the calls of {{$i.FakeName}}.
+{{with $sym := gensym}}func (f{{$sym}} *{{$i.FakeName}}) AssertCallOrder(t {{$.TestingT.Type $i.Name}}, methods{{$sym}} ...string) {
+	t.Helper()
+{{if $.Features.Sync}}	f{{$sym}}.mutex.RLock()
+	defer f{{$sym}}.mutex.RUnlock()
+{{end}}	var calls{{$sym}} []CharlatanCall
+{{range $i.Methods}}	for _, call{{$sym}} := range f{{$sym}}.{{.CallsName}} {
+		calls{{$sym}} = append(calls{{$sym}}, call{{$sym}})
+	}
+{{end}}	calls{{$sym}} = charlatanSortCalls(calls{{$sym}})
+
+	next{{$sym}} := 0
+	for _, call{{$sym}} := range calls{{$sym}} {
+		if next{{$sym}} < len(methods{{$sym}}) && call{{$sym}}.CallName() == "{{$i.FakeName}}."+methods{{$sym}}[next{{$sym}}] {
+			next{{$sym}}++
+		}
+	}
+
+	if next{{$sym}} != len(methods{{$sym}}) {
+		t.Errorf("{{$i.FakeName}} methods not called in the order %q, actual order: %s", methods{{$sym}}, charlatanCallNames(calls{{$sym}}))
+	}
+}{{end}}{{end}}{{/* end if and $.Features.Order $.Features.Assert */}}
 {{end}}{{/* end range .Interfaces */}}
+{{define "shared"}}{{/* declarations shared by all fakes in the output package */}}{{if and .Features.TestingT .TestingT.Shared}}
+// TestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
+type TestingT interface {
+	Error(...interface{})
+	Errorf(string, ...interface{})
+	Fatal(...interface{})
+	Helper()
+}
+{{end}}{{if .Features.Matchers}}
+// CharlatanMatcher matches a parameter of a call to a charlatan Fake, see CharlatanAny, CharlatanEq, CharlatanNot,
+// CharlatanFunc and CharlatanFieldsEq
+type CharlatanMatcher interface {
+	Match(value interface{}) bool
+}
+
+// CharlatanMatcherFunc is a CharlatanMatcher implemented by a predicate
+type CharlatanMatcherFunc func(value interface{}) bool
+
+// Match returns the result of the predicate for the value
+func (m CharlatanMatcherFunc) Match(value interface{}) bool {
+	return m(value)
+}
+
+// CharlatanAny returns a CharlatanMatcher of any value
+func CharlatanAny() CharlatanMatcher {
+	return CharlatanMatcherFunc(func(interface{}) bool {
+		return true
+	})
+}
+
+// CharlatanEq returns a CharlatanMatcher of values deeply equal to expected.  If expected is a CharlatanMatcher it is
+// returned as is.
+func CharlatanEq(expected interface{}) CharlatanMatcher {
+	if m, ok := expected.(CharlatanMatcher); ok {
+		return m
+	}
+
+	return CharlatanMatcherFunc(func(value interface{}) bool {
+		return reflect.DeepEqual(expected, value)
+	})
+}
+
+// CharlatanNot returns a CharlatanMatcher of the values not matched by m
+func CharlatanNot(m CharlatanMatcher) CharlatanMatcher {
+	return CharlatanMatcherFunc(func(value interface{}) bool {
+		return !m.Match(value)
+	})
+}
+
+// CharlatanFunc returns a CharlatanMatcher of the values for which the predicate returns true
+func CharlatanFunc(predicate func(value interface{}) bool) CharlatanMatcher {
+	return CharlatanMatcherFunc(predicate)
+}
+
+// CharlatanFieldsEq returns a CharlatanMatcher of structs, or pointers to structs, with the named fields equal to the
+// given values, e.g. CharlatanFieldsEq("UserID", 42, "Name", CharlatanNot(CharlatanEq(""))).  The arguments alternate
+// field names and values, which are compared as by CharlatanEq.
+func CharlatanFieldsEq(namesAndValues ...interface{}) CharlatanMatcher {
+	if len(namesAndValues)%2 != 0 {
+		panic("CharlatanFieldsEq called with a field name and no value")
+	}
+
+	return CharlatanMatcherFunc(func(value interface{}) bool {
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return false
+		}
+
+		for i := 0; i < len(namesAndValues); i += 2 {
+			name, _ := namesAndValues[i].(string)
+			field := v.FieldByName(name)
+			if !field.IsValid() || !field.CanInterface() || !CharlatanEq(namesAndValues[i+1]).Match(field.Interface()) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+{{end}}{{if .Features.Sequences}}
+// CharlatanExhausted is called by the stub sequences of the charlatan Fakes for each call made once all their results
+// have been returned, with the name of the method and the number of results.  See CharlatanRepeatLast,
+// CharlatanPanicWhenExhausted and CharlatanFailWhenExhausted.
+type CharlatanExhausted func(method string, results int)
+
+// CharlatanRepeatLast returns a CharlatanExhausted that lets a stub sequence return its last results again
+func CharlatanRepeatLast() CharlatanExhausted {
+	return func(string, int) {}
+}
+
+// CharlatanPanicWhenExhausted returns a CharlatanExhausted that panics when a stub sequence is called after its last
+// results
+func CharlatanPanicWhenExhausted() CharlatanExhausted {
+	return func(method string, results int) {
+		panic(method + "() called after the last results of its stub sequence")
+	}
+}
+
+// CharlatanFailWhenExhausted returns a CharlatanExhausted that calls t.Errorf when a stub sequence is called after its
+// last results
+func CharlatanFailWhenExhausted(t interface {
+	Errorf(string, ...interface{})
+}) CharlatanExhausted {
+	return func(method string, results int) {
+		t.Errorf("%s() called more than %d times, the length of its stub sequence", method, results)
+	}
+}
+{{end}}{{if .Features.Order}}
+// CharlatanCall is a call recorded by a charlatan Fake, see CharlatanInOrder
+type CharlatanCall interface {
+	// CallName returns the name of the method called, e.g. "FakeTx.Begin"
+	CallName() string
+	// CallSequence returns the position of the call among the calls of all the fakes in the package
+	CallSequence() uint64
+	// N.B. - the sequences of the fakes of other packages are counted separately, their calls are not comparable
+	charlatanCall()
+}
+
+// CharlatanInOrder calls t.Error if the calls, of any fakes in the package, were not made in the given order.  The
+// error reports the order the calls were made in.
+func CharlatanInOrder(t interface {
+	Helper()
+	Errorf(string, ...interface{})
+}, calls ...CharlatanCall) {
+	t.Helper()
+	for i := 1; i < len(calls); i++ {
+		if calls[i].CallSequence() <= calls[i-1].CallSequence() {
+			t.Errorf("calls not made in the order %s, actual order: %s", charlatanCallNames(calls), charlatanCallNames(charlatanSortCalls(calls)))
+			return
+		}
+	}
+}
+
+// charlatanCallSequence is the position of the last call among the calls of all the fakes in the package
+var charlatanCallSequence uint64
+
+// charlatanNextCall returns the position of a new call
+func charlatanNextCall() uint64 {
+	return atomic.AddUint64(&charlatanCallSequence, 1)
+}
+
+// charlatanSortCalls returns a copy of the calls sorted in the order they were made
+func charlatanSortCalls(calls []CharlatanCall) []CharlatanCall {
+	sorted := append([]CharlatanCall(nil), calls...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].CallSequence() < sorted[j].CallSequence()
+	})
+
+	return sorted
+}
+
+// charlatanCallNames returns the names of the calls separated by commas
+func charlatanCallNames(calls []CharlatanCall) string {
+	names := make([]string, len(calls))
+	for i, call := range calls {
+		names[i] = call.CallName()
+	}
+
+	return strings.Join(names, ", ")
+}
+{{end}}{{if .Features.Cassettes}}
+// charlatanErrorMessage returns the message of an error recorded in a cassette, or nil
+func charlatanErrorMessage(err error) *string {
+	if err == nil {
+		return nil
+	}
+	message := err.Error()
+
+	return &message
+}
+
+// charlatanError returns an error with the message recorded in a cassette, or nil
+func charlatanError(message *string) error {
+	if message == nil {
+		return nil
+	}
+
+	return errors.New(*message)
+}
+{{end}}{{end}}
 `
 
+const selfTestTemplate = `{{with .Header}}{{.}}
+
+{{end}}// Code generated by "{{.CommandLine}}".  DO NOT EDIT.
+{{with .BuildTags}}
+//go:build {{.}}
+{{end}}
+package {{.PackageName}}
+
+import "testing"
+{{range .Imports}}import {{if .Alias}}{{.Alias}} {{end}}{{.Path}}
+{{end}}
+{{range $i := .Interfaces}}{{if $.Features.Constructors}}
+// selfCheck{{.FakeName}}T counts the failures reported by {{.FakeName}}
+type selfCheck{{.FakeName}}T struct {
+	testing.TB
+	failures int
+}
+
+func (t *selfCheck{{.FakeName}}T) Error(...interface{})          { t.failures++ }
+func (t *selfCheck{{.FakeName}}T) Errorf(string, ...interface{}) { t.failures++ }
+func (t *selfCheck{{.FakeName}}T) Fatal(...interface{})          { t.failures++ }
+func (t *selfCheck{{.FakeName}}T) Helper()                       {}
+{{end}}
+// Test{{.FakeName}}SelfCheck exercises the default constructors and Reset of {{.FakeName}}
+func Test{{.FakeName}}SelfCheck(t *testing.T) {
+	var _ {{.QualifiedName}} = new({{.FakeName}})
+{{if $.Features.Constructors}}
+	panics := {{.ConstructorName "DefaultPanic"}}()
+{{range .Methods}}	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("{{.FakeName}}.{{.Name}} did not panic")
+			}
+		}()
+		{{if .Results}}{{range $idx, $r := .Results}}{{if $idx}}, {{end}}_{{end}} = {{end}}panics.{{.Name}}({{.ZeroArguments}})
+	}()
+{{end}}
+	fatalT := &selfCheck{{$i.FakeName}}T{TB: t}
+	fatals := {{$i.ConstructorName "DefaultFatal"}}(fatalT)
+{{range $i.Methods}}	{{if .Results}}{{range $idx, $r := .Results}}{{if $idx}}, {{end}}_{{end}} = {{end}}fatals.{{.Name}}({{.ZeroArguments}})
+{{end}}	if fatalT.failures != {{len $i.Methods}} {
+		t.Errorf("{{$i.ConstructorName "DefaultFatal"}} reported %d failures, expected {{len $i.Methods}}", fatalT.failures)
+	}
+
+	errorT := &selfCheck{{$i.FakeName}}T{TB: t}
+	errs := {{$i.ConstructorName "DefaultError"}}(errorT)
+{{range $i.Methods}}	{{if .Results}}{{range $idx, $r := .Results}}{{if $idx}}, {{end}}_{{end}} = {{end}}errs.{{.Name}}({{.ZeroArguments}})
+{{end}}	if errorT.failures != {{len $i.Methods}} {
+		t.Errorf("{{$i.ConstructorName "DefaultError"}} reported %d failures, expected {{len $i.Methods}}", errorT.failures)
+	}
+
+	zeros := {{$i.ConstructorName "DefaultZero"}}()
+{{range $i.Methods}}	{{if .Results}}{{range $idx, $r := .Results}}{{if $idx}}, {{end}}_{{end}} = {{end}}zeros.{{.Name}}({{.ZeroArguments}})
+{{end}}{{end}}{{/* end if $.Features.Constructors */}}{{if $.Features.Calls}}
+	f := new({{.FakeName}})
+{{range .Methods}}	f.{{.CallsName}} = append(f.{{.CallsName}}, new({{.InvocationName}}))
+{{end}}	f.Reset()
+{{range .Methods}}	if len(f.{{.CallsName}}) != 0 {
+		t.Error("{{.FakeName}}.Reset did not clear {{.CallsName}}")
+	}
+{{end}}{{end}}{{/* end if $.Features.Calls */}}}
+{{end}}{{/* end range .Interfaces */}}`
+
 var (
-	symGen = symbolGenerator{Prefix: "_sym"}
-	funky  = template.FuncMap{"gensym": func() string { return symGen.next() }}
-	tmpl   = template.Must(template.New("charlatan").Funcs(funky).Parse(sourceTemplate))
+	funky = template.FuncMap{
+		// N.B. - replaced by a new generator each time the source template is executed to produce the same output
+		"gensym":    newSymbolGenerator().next,
+		"lower":     strings.ToLower,
+		"join":      join,
+		"zeroValue": zeroValue,
+		"cassette":  cassette,
+		"jsonTag":   jsonTag,
+	}
+	tmpl = template.Must(template.New("charlatan").Funcs(funky).Parse(sourceTemplate))
+	// selfTestTmpl produces the optional companion test of the fakes
+	selfTestTmpl = template.Must(template.New("selftest").Funcs(funky).Parse(selfTestTemplate))
 )
 
+// LoadTemplate parses a user supplied template file.  If partialsDir is not empty then all the "*.tmpl" files in
+// it are parsed as well, and can be referenced by their file names from the main template.
+func LoadTemplate(path, partialsDir string) (*template.Template, error) {
+	t, err := template.New(filepath.Base(path)).Funcs(funky).ParseFiles(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load template: %s", err)
+	}
+	if partialsDir == "" {
+		return t, nil
+	}
+
+	partials, err := filepath.Glob(filepath.Join(partialsDir, "*.tmpl"))
+	if err != nil {
+		return nil, fmt.Errorf("cannot load template partials: %s", err)
+	}
+	if len(partials) == 0 {
+		return t, nil
+	}
+	if _, err := t.ParseFiles(partials...); err != nil {
+		return nil, fmt.Errorf("cannot load template partials: %s", err)
+	}
+
+	return t, nil
+}
+
+// charlatanTemplate is the model given to the template that produces the output source.  User supplied templates
+// receive the same model, so its exported fields and methods are a stable interface.
 type charlatanTemplate struct {
-	CommandLine string
-	PackageName string
-	Imports     []*Import
-	Interfaces  []*Interface
+	CommandLine string          // the command line that produced the output
+	Header      string          // the comment prepended to the output, if any
+	BuildTags   string          // the build constraint expression of the output, if any
+	PackageName string          // the package name of the output source
+	Imports     []*Import       // the imports required by the interfaces
+	Interfaces  []*Interface    // the interfaces to generate fakes for
+	Shared      bool            // true if the declarations shared by all fakes should be included
+	Features    Features        // the groups of declarations to generate for each fake
+	TestingT    TestingT        // the type of the testing parameters
+	declared    map[string]bool // the shared names declared by other files of the package, left out of the output
+	template    *template.Template
 }
 
 func (t *charlatanTemplate) execute() ([]byte, error) {
+	current := t.template
+	if current == nil {
+		current = tmpl
+	}
+	current, err := current.Clone()
+	if err != nil {
+		return nil, err
+	}
+	current.Funcs(template.FuncMap{"gensym": newSymbolGenerator().next})
+
 	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, t); err != nil {
+	if err := current.Execute(&buf, t); err != nil {
 		return nil, err
 	}
 
-	src, err := imports.Process("", buf.Bytes(), nil)
+	raw := buf.Bytes()
+	if len(t.declared) != 0 {
+		raw = removeDeclarations(raw, t.declared)
+	}
+	src, err := imports.Process("", raw, nil)
 	if err != nil {
-		// Should not happen except when developing this code.
-		// The user can compile the output to see the error.
-		return buf.Bytes(), fmt.Errorf("internal error: invalid code generated: %s", err)
+		// Should not happen except when developing this code or a template.
+		// The raw output is returned to help find the error, but is never written.
+		return raw, fmt.Errorf("internal error: invalid code generated: %s", err)
 	}
 
 	return src, nil
 }
 
+// NeedsReflect returns true if the selected features compare parameters, and reflect is not already imported
 func (t *charlatanTemplate) NeedsReflect() bool {
-	var needed bool
+	needed := t.Shared && t.Features.Matchers
 	for _, intf := range t.Interfaces {
 		for _, mthd := range intf.Methods {
-			if len(mthd.Parameters) > 0 {
+			if len(mthd.Parameters) == 0 {
+				continue
+			}
+			if t.Features.Called || t.Features.Assert {
+				needed = true
+			}
+			if len(mthd.Results) > 0 && (t.Features.Invocations || t.Features.ResultsForCall) {
 				needed = true
 			}
 		}
@@ -332,3 +1212,70 @@ func (t *charlatanTemplate) NeedsReflect() bool {
 
 	return needed
 }
+
+// Imported returns true if the interfaces already import the package with the given path
+func (t *charlatanTemplate) Imported(path string) bool {
+	for _, imp := range t.Imports {
+		if imp.Path == strconv.Quote(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// join concatenates the string forms of the elements of a slice, separated by sep
+func join(sep string, elems interface{}) (string, error) {
+	v := reflect.ValueOf(elems)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return "", fmt.Errorf("join: expected a slice, have: %T", elems)
+	}
+
+	values := make([]string, v.Len())
+	for i := range values {
+		values[i] = fmt.Sprint(v.Index(i).Interface())
+	}
+
+	return strings.Join(values, sep), nil
+}
+
+// zeroValue returns an expression for the zero value of the given type
+func zeroValue(t Type) string {
+	switch actual := t.(type) {
+	case *Array:
+		if actual.scale != "" {
+			return actual.FieldFormat() + "{}"
+		}
+		return "nil"
+	case *Pointer, *Map, *Channel, *SendChannel, *ReceiveChannel, *Ellipsis:
+		return "nil"
+	case *BasicType:
+		if actual.Qualifier != "" {
+			break
+		}
+		switch actual.Name {
+		case "bool":
+			return "false"
+		case "string":
+			return `""`
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+			"byte", "rune", "float32", "float64", "complex64", "complex128":
+			return "0"
+		case "error", "any":
+			return "nil"
+		}
+		if strings.HasPrefix(actual.Name, "interface") || strings.HasPrefix(actual.Name, "func") {
+			return "nil"
+		}
+		if strings.HasPrefix(actual.Name, "struct") {
+			return actual.Name + "{}"
+		}
+	}
+
+	// N.B. - valid for any type, including named types whose kind is unknown
+	return fmt.Sprintf("*new(%s)", t.FieldFormat())
+}
+
+func newSymbolGenerator() *symbolGenerator {
+	return &symbolGenerator{Prefix: "_sym"}
+}
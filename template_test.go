@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTemplate(t *testing.T) {
+	tmpl, err := LoadTemplate("testdata/template/stub.tmpl", "testdata/template/partials")
+	if err != nil {
+		t.Fatalf("LoadTemplate error: %s", err)
+	}
+
+	g, err := parsePackage("testdata/multireturner", []string{"testdata/multireturner/multireturner_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	g.Template = tmpl
+
+	got, err := g.Generate([]string{"Multireturner"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	src := string(got)
+	assert.Contains(t, src, "// Copyright Example Corp.  All rights reserved.")
+	assert.Contains(t, src, "type StubMultireturner struct")
+	assert.Contains(t, src, "// multireturn()")
+	assert.Contains(t, src, `return "", 0`)
+	assert.Contains(t, src, "return 0, 0, 0, 0")
+}
+
+func TestLoadTemplateMissing(t *testing.T) {
+	_, err := LoadTemplate("testdata/template/missing.tmpl", "")
+
+	assert.Error(t, err)
+}
+
+func TestZeroValue(t *testing.T) {
+	tests := []struct {
+		t        Type
+		expected string
+	}{
+		{&BasicType{Name: "string"}, `""`},
+		{&BasicType{Name: "bool"}, "false"},
+		{&BasicType{Name: "float64"}, "0"},
+		{&BasicType{Name: "error"}, "nil"},
+		{&BasicType{Name: "Thing"}, "*new(Thing)"},
+		{&BasicType{Name: "Reader", Qualifier: "io"}, "*new(io.Reader)"},
+		{&BasicType{Name: "struct{}"}, "struct{}{}"},
+		{&Pointer{subType: &BasicType{Name: "Thing"}}, "nil"},
+		{&Array{subType: &BasicType{Name: "int"}}, "nil"},
+		{&Array{subType: &BasicType{Name: "int"}, scale: "3"}, "[3]int{}"},
+		{&Map{keyType: &BasicType{Name: "string"}, subType: &BasicType{Name: "int"}}, "nil"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, zeroValue(test.t))
+	}
+}
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/array -output=testdata/array/array.go Array".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/array -output=testdata/array/array.go Array".  DO NOT EDIT.
 
 package main
 
@@ -56,13 +56,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeArrayParameter ...
+		// assert state of FakeArray ...
 		f.AssertArrayParameterCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeArrayParameter.
+unexpected calls are made to FakeArray.
 */
 type FakeArray struct {
 	ArrayParameterHook func([3]string)
@@ -76,6 +76,8 @@ type FakeArray struct {
 	SliceReturnCalls    []*ArraySliceReturnInvocation
 }
 
+var _ Array = (*FakeArray)(nil)
+
 // NewFakeArrayDefaultPanic returns an instance of FakeArray with all hooks configured to panic
 func NewFakeArrayDefaultPanic() *FakeArray {
 	return &FakeArray{
@@ -138,6 +140,54 @@ func NewFakeArrayDefaultError(t_sym2 ArrayTestingT) *FakeArray {
 	}
 }
 
+// NewFakeArrayDefaultZero returns an instance of FakeArray with all hooks configured to return zero values
+func NewFakeArrayDefaultZero() *FakeArray {
+	return &FakeArray{
+		ArrayParameterHook: func([3]string) {
+			return
+		},
+		ArrayReturnHook: func() (ident1 [3]string) {
+			return
+		},
+		SliceParameterHook: func([]string) {
+			return
+		},
+		SliceReturnHook: func() (ident1 []string) {
+			return
+		},
+	}
+}
+
+// NewFakeArrayDefaultErr returns an instance of FakeArray with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeArrayDefaultErr(err_sym3 error) *FakeArray {
+	return &FakeArray{
+		ArrayParameterHook: func([3]string) {
+			return
+		},
+		ArrayReturnHook: func() (ident1 [3]string) {
+			return
+		},
+		SliceParameterHook: func([]string) {
+			return
+		},
+		SliceReturnHook: func() (ident1 []string) {
+			return
+		},
+	}
+}
+
+// NewFakeArraySpy returns an instance of FakeArray with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeArraySpy(real_sym4 Array) *FakeArray {
+	return &FakeArray{
+		ArrayParameterHook: real_sym4.ArrayParameter,
+		ArrayReturnHook:    real_sym4.ArrayReturn,
+		SliceParameterHook: real_sym4.SliceParameter,
+		SliceReturnHook:    real_sym4.SliceReturn,
+	}
+}
+
 func (f *FakeArray) Reset() {
 	f.ArrayParameterCalls = []*ArrayArrayParameterInvocation{}
 	f.ArrayReturnCalls = []*ArrayArrayReturnInvocation{}
@@ -145,17 +195,17 @@ func (f *FakeArray) Reset() {
 	f.SliceReturnCalls = []*ArraySliceReturnInvocation{}
 }
 
-func (f_sym3 *FakeArray) ArrayParameter(ident1 [3]string) {
-	if f_sym3.ArrayParameterHook == nil {
+func (f_sym5 *FakeArray) ArrayParameter(ident1 [3]string) {
+	if f_sym5.ArrayParameterHook == nil {
 		panic("Array.ArrayParameter() called but FakeArray.ArrayParameterHook is nil")
 	}
 
-	invocation_sym3 := new(ArrayArrayParameterInvocation)
-	f_sym3.ArrayParameterCalls = append(f_sym3.ArrayParameterCalls, invocation_sym3)
+	invocation_sym5 := new(ArrayArrayParameterInvocation)
+	f_sym5.ArrayParameterCalls = append(f_sym5.ArrayParameterCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation_sym5.Parameters.Ident1 = ident1
 
-	f_sym3.ArrayParameterHook(ident1)
+	f_sym5.ArrayParameterHook(ident1)
 
 	return
 }
@@ -213,9 +263,9 @@ func (f *FakeArray) AssertArrayParameterCalledN(t ArrayTestingT, n int) {
 }
 
 // ArrayParameterCalledWith returns true if FakeArray.ArrayParameter was called with the given values
-func (f_sym4 *FakeArray) ArrayParameterCalledWith(ident1 [3]string) bool {
-	for _, call_sym4 := range f_sym4.ArrayParameterCalls {
-		if reflect.DeepEqual(call_sym4.Parameters.Ident1, ident1) {
+func (f_sym6 *FakeArray) ArrayParameterCalledWith(ident1 [3]string) bool {
+	for _, call_sym6 := range f_sym6.ArrayParameterCalls {
+		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -224,66 +274,66 @@ func (f_sym4 *FakeArray) ArrayParameterCalledWith(ident1 [3]string) bool {
 }
 
 // AssertArrayParameterCalledWith calls t.Error if FakeArray.ArrayParameter was not called with the given values
-func (f_sym5 *FakeArray) AssertArrayParameterCalledWith(t ArrayTestingT, ident1 [3]string) {
+func (f_sym7 *FakeArray) AssertArrayParameterCalledWith(t ArrayTestingT, ident1 [3]string) {
 	t.Helper()
-	var found_sym5 bool
-	for _, call_sym5 := range f_sym5.ArrayParameterCalls {
-		if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-			found_sym5 = true
+	var found_sym7 bool
+	for _, call_sym7 := range f_sym7.ArrayParameterCalls {
+		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
+			found_sym7 = true
 			break
 		}
 	}
 
-	if !found_sym5 {
+	if !found_sym7 {
 		t.Error("FakeArray.ArrayParameter not called with expected parameters")
 	}
 }
 
 // ArrayParameterCalledOnceWith returns true if FakeArray.ArrayParameter was called exactly once with the given values
-func (f_sym6 *FakeArray) ArrayParameterCalledOnceWith(ident1 [3]string) bool {
-	var count_sym6 int
-	for _, call_sym6 := range f_sym6.ArrayParameterCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
-			count_sym6++
+func (f_sym8 *FakeArray) ArrayParameterCalledOnceWith(ident1 [3]string) bool {
+	var count_sym8 int
+	for _, call_sym8 := range f_sym8.ArrayParameterCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
+			count_sym8++
 		}
 	}
 
-	return count_sym6 == 1
+	return count_sym8 == 1
 }
 
 // AssertArrayParameterCalledOnceWith calls t.Error if FakeArray.ArrayParameter was not called exactly once with the given values
-func (f_sym7 *FakeArray) AssertArrayParameterCalledOnceWith(t ArrayTestingT, ident1 [3]string) {
+func (f_sym9 *FakeArray) AssertArrayParameterCalledOnceWith(t ArrayTestingT, ident1 [3]string) {
 	t.Helper()
-	var count_sym7 int
-	for _, call_sym7 := range f_sym7.ArrayParameterCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			count_sym7++
+	var count_sym9 int
+	for _, call_sym9 := range f_sym9.ArrayParameterCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
+			count_sym9++
 		}
 	}
 
-	if count_sym7 != 1 {
-		t.Errorf("FakeArray.ArrayParameter called %d times with expected parameters, expected one", count_sym7)
+	if count_sym9 != 1 {
+		t.Errorf("FakeArray.ArrayParameter called %d times with expected parameters, expected one", count_sym9)
 	}
 }
 
-func (f_sym8 *FakeArray) ArrayReturn() (ident1 [3]string) {
-	if f_sym8.ArrayReturnHook == nil {
+func (f_sym10 *FakeArray) ArrayReturn() (ident1 [3]string) {
+	if f_sym10.ArrayReturnHook == nil {
 		panic("Array.ArrayReturn() called but FakeArray.ArrayReturnHook is nil")
 	}
 
-	invocation_sym8 := new(ArrayArrayReturnInvocation)
-	f_sym8.ArrayReturnCalls = append(f_sym8.ArrayReturnCalls, invocation_sym8)
+	invocation_sym10 := new(ArrayArrayReturnInvocation)
+	f_sym10.ArrayReturnCalls = append(f_sym10.ArrayReturnCalls, invocation_sym10)
 
-	ident1 = f_sym8.ArrayReturnHook()
+	ident1 = f_sym10.ArrayReturnHook()
 
-	invocation_sym8.Results.Ident1 = ident1
+	invocation_sym10.Results.Ident1 = ident1
 
 	return
 }
 
 // SetArrayReturnStub configures Array.ArrayReturn to always return the given values
-func (f_sym9 *FakeArray) SetArrayReturnStub(ident1 [3]string) {
-	f_sym9.ArrayReturnHook = func() [3]string {
+func (f_sym11 *FakeArray) SetArrayReturnStub(ident1 [3]string) {
+	f_sym11.ArrayReturnHook = func() [3]string {
 		return ident1
 	}
 }
@@ -340,17 +390,17 @@ func (f *FakeArray) AssertArrayReturnCalledN(t ArrayTestingT, n int) {
 	}
 }
 
-func (f_sym10 *FakeArray) SliceParameter(ident1 []string) {
-	if f_sym10.SliceParameterHook == nil {
+func (f_sym12 *FakeArray) SliceParameter(ident1 []string) {
+	if f_sym12.SliceParameterHook == nil {
 		panic("Array.SliceParameter() called but FakeArray.SliceParameterHook is nil")
 	}
 
-	invocation_sym10 := new(ArraySliceParameterInvocation)
-	f_sym10.SliceParameterCalls = append(f_sym10.SliceParameterCalls, invocation_sym10)
+	invocation_sym12 := new(ArraySliceParameterInvocation)
+	f_sym12.SliceParameterCalls = append(f_sym12.SliceParameterCalls, invocation_sym12)
 
-	invocation_sym10.Parameters.Ident1 = ident1
+	invocation_sym12.Parameters.Ident1 = ident1
 
-	f_sym10.SliceParameterHook(ident1)
+	f_sym12.SliceParameterHook(ident1)
 
 	return
 }
@@ -408,9 +458,9 @@ func (f *FakeArray) AssertSliceParameterCalledN(t ArrayTestingT, n int) {
 }
 
 // SliceParameterCalledWith returns true if FakeArray.SliceParameter was called with the given values
-func (f_sym11 *FakeArray) SliceParameterCalledWith(ident1 []string) bool {
-	for _, call_sym11 := range f_sym11.SliceParameterCalls {
-		if reflect.DeepEqual(call_sym11.Parameters.Ident1, ident1) {
+func (f_sym13 *FakeArray) SliceParameterCalledWith(ident1 []string) bool {
+	for _, call_sym13 := range f_sym13.SliceParameterCalls {
+		if reflect.DeepEqual(call_sym13.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -419,66 +469,66 @@ func (f_sym11 *FakeArray) SliceParameterCalledWith(ident1 []string) bool {
 }
 
 // AssertSliceParameterCalledWith calls t.Error if FakeArray.SliceParameter was not called with the given values
-func (f_sym12 *FakeArray) AssertSliceParameterCalledWith(t ArrayTestingT, ident1 []string) {
+func (f_sym14 *FakeArray) AssertSliceParameterCalledWith(t ArrayTestingT, ident1 []string) {
 	t.Helper()
-	var found_sym12 bool
-	for _, call_sym12 := range f_sym12.SliceParameterCalls {
-		if reflect.DeepEqual(call_sym12.Parameters.Ident1, ident1) {
-			found_sym12 = true
+	var found_sym14 bool
+	for _, call_sym14 := range f_sym14.SliceParameterCalls {
+		if reflect.DeepEqual(call_sym14.Parameters.Ident1, ident1) {
+			found_sym14 = true
 			break
 		}
 	}
 
-	if !found_sym12 {
+	if !found_sym14 {
 		t.Error("FakeArray.SliceParameter not called with expected parameters")
 	}
 }
 
 // SliceParameterCalledOnceWith returns true if FakeArray.SliceParameter was called exactly once with the given values
-func (f_sym13 *FakeArray) SliceParameterCalledOnceWith(ident1 []string) bool {
-	var count_sym13 int
-	for _, call_sym13 := range f_sym13.SliceParameterCalls {
-		if reflect.DeepEqual(call_sym13.Parameters.Ident1, ident1) {
-			count_sym13++
+func (f_sym15 *FakeArray) SliceParameterCalledOnceWith(ident1 []string) bool {
+	var count_sym15 int
+	for _, call_sym15 := range f_sym15.SliceParameterCalls {
+		if reflect.DeepEqual(call_sym15.Parameters.Ident1, ident1) {
+			count_sym15++
 		}
 	}
 
-	return count_sym13 == 1
+	return count_sym15 == 1
 }
 
 // AssertSliceParameterCalledOnceWith calls t.Error if FakeArray.SliceParameter was not called exactly once with the given values
-func (f_sym14 *FakeArray) AssertSliceParameterCalledOnceWith(t ArrayTestingT, ident1 []string) {
+func (f_sym16 *FakeArray) AssertSliceParameterCalledOnceWith(t ArrayTestingT, ident1 []string) {
 	t.Helper()
-	var count_sym14 int
-	for _, call_sym14 := range f_sym14.SliceParameterCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.Ident1, ident1) {
-			count_sym14++
+	var count_sym16 int
+	for _, call_sym16 := range f_sym16.SliceParameterCalls {
+		if reflect.DeepEqual(call_sym16.Parameters.Ident1, ident1) {
+			count_sym16++
 		}
 	}
 
-	if count_sym14 != 1 {
-		t.Errorf("FakeArray.SliceParameter called %d times with expected parameters, expected one", count_sym14)
+	if count_sym16 != 1 {
+		t.Errorf("FakeArray.SliceParameter called %d times with expected parameters, expected one", count_sym16)
 	}
 }
 
-func (f_sym15 *FakeArray) SliceReturn() (ident1 []string) {
-	if f_sym15.SliceReturnHook == nil {
+func (f_sym17 *FakeArray) SliceReturn() (ident1 []string) {
+	if f_sym17.SliceReturnHook == nil {
 		panic("Array.SliceReturn() called but FakeArray.SliceReturnHook is nil")
 	}
 
-	invocation_sym15 := new(ArraySliceReturnInvocation)
-	f_sym15.SliceReturnCalls = append(f_sym15.SliceReturnCalls, invocation_sym15)
+	invocation_sym17 := new(ArraySliceReturnInvocation)
+	f_sym17.SliceReturnCalls = append(f_sym17.SliceReturnCalls, invocation_sym17)
 
-	ident1 = f_sym15.SliceReturnHook()
+	ident1 = f_sym17.SliceReturnHook()
 
-	invocation_sym15.Results.Ident1 = ident1
+	invocation_sym17.Results.Ident1 = ident1
 
 	return
 }
 
 // SetSliceReturnStub configures Array.SliceReturn to always return the given values
-func (f_sym16 *FakeArray) SetSliceReturnStub(ident1 []string) {
-	f_sym16.SliceReturnHook = func() []string {
+func (f_sym18 *FakeArray) SetSliceReturnStub(ident1 []string) {
+	f_sym18.SliceReturnHook = func() []string {
 		return ident1
 	}
 }
@@ -0,0 +1,24 @@
+package main
+
+import "charlatan/testdata/missing"
+
+type Broken interface {
+	Fix(string) error
+}
+
+type Unresolved interface {
+	Resolve(Missing) error
+}
+
+type Embedder interface {
+	Unresolved
+	Other() int
+}
+
+type Importer interface {
+	Load(string) (missing.Thing, error)
+}
+
+func unrelated() int {
+	return "not an int"
+}
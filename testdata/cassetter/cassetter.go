@@ -0,0 +1,746 @@
+// Code generated by "charlatan -dir=testdata/cassetter -output=testdata/cassetter/cassetter.go Cassetter".  DO NOT EDIT.
+
+package main
+
+import "reflect"
+import "context"
+import "io"
+
+// CassetterFetchInvocation represents a single call of FakeCassetter.Fetch
+type CassetterFetchInvocation struct {
+	Parameters struct {
+		Ctx      context.Context
+		Id       string
+		Progress func(int)
+	}
+	Results struct {
+		Values []string
+		Err    error
+	}
+}
+
+// NewCassetterFetchInvocation creates a new instance of CassetterFetchInvocation
+func NewCassetterFetchInvocation(ctx context.Context, id string, progress func(int), values []string, err error) *CassetterFetchInvocation {
+	invocation := new(CassetterFetchInvocation)
+
+	invocation.Parameters.Ctx = ctx
+	invocation.Parameters.Id = id
+	invocation.Parameters.Progress = progress
+
+	invocation.Results.Values = values
+	invocation.Results.Err = err
+
+	return invocation
+}
+
+// CassetterGetInvocation represents a single call of FakeCassetter.Get
+type CassetterGetInvocation struct {
+	Parameters struct {
+		Ctx context.Context
+		R   io.Reader
+		Cb  Callback
+	}
+	Results struct {
+		Ident1 string
+		Err    error
+	}
+}
+
+// NewCassetterGetInvocation creates a new instance of CassetterGetInvocation
+func NewCassetterGetInvocation(ctx context.Context, r io.Reader, cb Callback, ident1 string, err error) *CassetterGetInvocation {
+	invocation := new(CassetterGetInvocation)
+
+	invocation.Parameters.Ctx = ctx
+	invocation.Parameters.R = r
+	invocation.Parameters.Cb = cb
+
+	invocation.Results.Ident1 = ident1
+	invocation.Results.Err = err
+
+	return invocation
+}
+
+// CassetterWatchInvocation represents a single call of FakeCassetter.Watch
+type CassetterWatchInvocation struct {
+	Parameters struct {
+		Ctx    context.Context
+		Events chan<- string
+	}
+	Results struct {
+		Err error
+	}
+}
+
+// NewCassetterWatchInvocation creates a new instance of CassetterWatchInvocation
+func NewCassetterWatchInvocation(ctx context.Context, events chan<- string, err error) *CassetterWatchInvocation {
+	invocation := new(CassetterWatchInvocation)
+
+	invocation.Parameters.Ctx = ctx
+	invocation.Parameters.Events = events
+
+	invocation.Results.Err = err
+
+	return invocation
+}
+
+// CassetterTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
+type CassetterTestingT interface {
+	Error(...interface{})
+	Errorf(string, ...interface{})
+	Fatal(...interface{})
+	Helper()
+}
+
+/*
+FakeCassetter is a mock implementation of Cassetter for testing.
+Use it in your tests as in this example:
+
+	package example
+
+	func TestWithCassetter(t *testing.T) {
+		f := &main.FakeCassetter{
+			FetchHook: func(ctx context.Context, id string, progress func(int)) (values []string, err error) {
+				// ensure parameters meet expectations, signal errors using t, etc
+				return
+			},
+		}
+
+		// test code goes here ...
+
+		// assert state of FakeCassetter ...
+		f.AssertFetchCalledOnce(t)
+	}
+
+Create anonymous function implementations for only those interface methods that
+should be called in the code under test.  This will force a panic if any
+unexpected calls are made to FakeCassetter.
+*/
+type FakeCassetter struct {
+	FetchHook func(context.Context, string, func(int)) ([]string, error)
+	GetHook   func(context.Context, io.Reader, Callback) (string, error)
+	WatchHook func(context.Context, chan<- string) error
+
+	FetchCalls []*CassetterFetchInvocation
+	GetCalls   []*CassetterGetInvocation
+	WatchCalls []*CassetterWatchInvocation
+}
+
+var _ Cassetter = (*FakeCassetter)(nil)
+
+// NewFakeCassetterDefaultPanic returns an instance of FakeCassetter with all hooks configured to panic
+func NewFakeCassetterDefaultPanic() *FakeCassetter {
+	return &FakeCassetter{
+		FetchHook: func(context.Context, string, func(int)) (values []string, err error) {
+			panic("Unexpected call to Cassetter.Fetch")
+		},
+		GetHook: func(context.Context, io.Reader, Callback) (ident1 string, err error) {
+			panic("Unexpected call to Cassetter.Get")
+		},
+		WatchHook: func(context.Context, chan<- string) (err error) {
+			panic("Unexpected call to Cassetter.Watch")
+		},
+	}
+}
+
+// NewFakeCassetterDefaultFatal returns an instance of FakeCassetter with all hooks configured to call t.Fatal
+func NewFakeCassetterDefaultFatal(t_sym1 CassetterTestingT) *FakeCassetter {
+	return &FakeCassetter{
+		FetchHook: func(context.Context, string, func(int)) (values []string, err error) {
+			t_sym1.Fatal("Unexpected call to Cassetter.Fetch")
+			return
+		},
+		GetHook: func(context.Context, io.Reader, Callback) (ident1 string, err error) {
+			t_sym1.Fatal("Unexpected call to Cassetter.Get")
+			return
+		},
+		WatchHook: func(context.Context, chan<- string) (err error) {
+			t_sym1.Fatal("Unexpected call to Cassetter.Watch")
+			return
+		},
+	}
+}
+
+// NewFakeCassetterDefaultError returns an instance of FakeCassetter with all hooks configured to call t.Error
+func NewFakeCassetterDefaultError(t_sym2 CassetterTestingT) *FakeCassetter {
+	return &FakeCassetter{
+		FetchHook: func(context.Context, string, func(int)) (values []string, err error) {
+			t_sym2.Error("Unexpected call to Cassetter.Fetch")
+			return
+		},
+		GetHook: func(context.Context, io.Reader, Callback) (ident1 string, err error) {
+			t_sym2.Error("Unexpected call to Cassetter.Get")
+			return
+		},
+		WatchHook: func(context.Context, chan<- string) (err error) {
+			t_sym2.Error("Unexpected call to Cassetter.Watch")
+			return
+		},
+	}
+}
+
+// NewFakeCassetterDefaultZero returns an instance of FakeCassetter with all hooks configured to return zero values
+func NewFakeCassetterDefaultZero() *FakeCassetter {
+	return &FakeCassetter{
+		FetchHook: func(context.Context, string, func(int)) (values []string, err error) {
+			return
+		},
+		GetHook: func(context.Context, io.Reader, Callback) (ident1 string, err error) {
+			return
+		},
+		WatchHook: func(context.Context, chan<- string) (err error) {
+			return
+		},
+	}
+}
+
+// NewFakeCassetterDefaultErr returns an instance of FakeCassetter with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeCassetterDefaultErr(err_sym3 error) *FakeCassetter {
+	return &FakeCassetter{
+		FetchHook: func(context.Context, string, func(int)) (values []string, err error) {
+			return nil, err_sym3
+		},
+		GetHook: func(context.Context, io.Reader, Callback) (ident1 string, err error) {
+			return "", err_sym3
+		},
+		WatchHook: func(context.Context, chan<- string) (err error) {
+			return err_sym3
+		},
+	}
+}
+
+// NewFakeCassetterSpy returns an instance of FakeCassetter with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeCassetterSpy(real_sym4 Cassetter) *FakeCassetter {
+	return &FakeCassetter{
+		FetchHook: real_sym4.Fetch,
+		GetHook:   real_sym4.Get,
+		WatchHook: real_sym4.Watch,
+	}
+}
+
+func (f *FakeCassetter) Reset() {
+	f.FetchCalls = []*CassetterFetchInvocation{}
+	f.GetCalls = []*CassetterGetInvocation{}
+	f.WatchCalls = []*CassetterWatchInvocation{}
+}
+
+// FailAll configures every method of Cassetter whose last result is an error to return err and zero values, see
+// Set*Error.  A strict fake does not require the methods to be called.
+func (f *FakeCassetter) FailAll(err error) {
+	f.SetFetchError(err)
+	f.SetGetError(err)
+	f.SetWatchError(err)
+}
+
+func (f_sym5 *FakeCassetter) Fetch(ctx context.Context, id string, progress func(int)) (values []string, err error) {
+	if f_sym5.FetchHook == nil {
+		panic("Cassetter.Fetch() called but FakeCassetter.FetchHook is nil")
+	}
+
+	invocation_sym5 := new(CassetterFetchInvocation)
+	f_sym5.FetchCalls = append(f_sym5.FetchCalls, invocation_sym5)
+
+	invocation_sym5.Parameters.Ctx = ctx
+	invocation_sym5.Parameters.Id = id
+	invocation_sym5.Parameters.Progress = progress
+
+	values, err = f_sym5.FetchHook(ctx, id, progress)
+
+	invocation_sym5.Results.Values = values
+	invocation_sym5.Results.Err = err
+
+	return
+}
+
+// SetFetchStub configures Cassetter.Fetch to always return the given values
+func (f_sym6 *FakeCassetter) SetFetchStub(values []string, err error) {
+	f_sym6.FetchHook = func(context.Context, string, func(int)) ([]string, error) {
+		return values, err
+	}
+}
+
+// SetFetchError configures Cassetter.Fetch to always return err and zero values.  Unlike a stub, a strict
+// fake does not require the method to be called.
+func (f_sym7 *FakeCassetter) SetFetchError(err_sym7 error) {
+	f_sym7.FetchHook = func(context.Context, string, func(int)) ([]string, error) {
+		return nil, err_sym7
+	}
+}
+
+// SetFetchInvocation configures Cassetter.Fetch to return the given results when called with the given parameters
+// If no match is found for an invocation the result(s) of the fallback function are returned
+func (f_sym8 *FakeCassetter) SetFetchInvocation(calls_sym8 []*CassetterFetchInvocation, fallback_sym8 func() ([]string, error)) {
+	f_sym8.FetchHook = func(ctx context.Context, id string, progress func(int)) (values []string, err error) {
+		for _, call_sym8 := range calls_sym8 {
+			if reflect.DeepEqual(call_sym8.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym8.Parameters.Id, id) && reflect.DeepEqual(call_sym8.Parameters.Progress, progress) {
+				values = call_sym8.Results.Values
+				err = call_sym8.Results.Err
+
+				return
+			}
+		}
+
+		return fallback_sym8()
+	}
+}
+
+// FetchCalled returns true if FakeCassetter.Fetch was called
+func (f *FakeCassetter) FetchCalled() bool {
+	return len(f.FetchCalls) != 0
+}
+
+// AssertFetchCalled calls t.Error if FakeCassetter.Fetch was not called
+func (f *FakeCassetter) AssertFetchCalled(t CassetterTestingT) {
+	t.Helper()
+	if len(f.FetchCalls) == 0 {
+		t.Error("FakeCassetter.Fetch not called, expected at least one")
+	}
+}
+
+// FetchNotCalled returns true if FakeCassetter.Fetch was not called
+func (f *FakeCassetter) FetchNotCalled() bool {
+	return len(f.FetchCalls) == 0
+}
+
+// AssertFetchNotCalled calls t.Error if FakeCassetter.Fetch was called
+func (f *FakeCassetter) AssertFetchNotCalled(t CassetterTestingT) {
+	t.Helper()
+	if len(f.FetchCalls) != 0 {
+		t.Error("FakeCassetter.Fetch called, expected none")
+	}
+}
+
+// FetchCalledOnce returns true if FakeCassetter.Fetch was called exactly once
+func (f *FakeCassetter) FetchCalledOnce() bool {
+	return len(f.FetchCalls) == 1
+}
+
+// AssertFetchCalledOnce calls t.Error if FakeCassetter.Fetch was not called exactly once
+func (f *FakeCassetter) AssertFetchCalledOnce(t CassetterTestingT) {
+	t.Helper()
+	if len(f.FetchCalls) != 1 {
+		t.Errorf("FakeCassetter.Fetch called %d times, expected 1", len(f.FetchCalls))
+	}
+}
+
+// FetchCalledN returns true if FakeCassetter.Fetch was called at least n times
+func (f *FakeCassetter) FetchCalledN(n int) bool {
+	return len(f.FetchCalls) >= n
+}
+
+// AssertFetchCalledN calls t.Error if FakeCassetter.Fetch was called less than n times
+func (f *FakeCassetter) AssertFetchCalledN(t CassetterTestingT, n int) {
+	t.Helper()
+	if len(f.FetchCalls) < n {
+		t.Errorf("FakeCassetter.Fetch called %d times, expected >= %d", len(f.FetchCalls), n)
+	}
+}
+
+// FetchCalledWith returns true if FakeCassetter.Fetch was called with the given values
+func (f_sym9 *FakeCassetter) FetchCalledWith(ctx context.Context, id string, progress func(int)) bool {
+	for _, call_sym9 := range f_sym9.FetchCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym9.Parameters.Id, id) && reflect.DeepEqual(call_sym9.Parameters.Progress, progress) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertFetchCalledWith calls t.Error if FakeCassetter.Fetch was not called with the given values
+func (f_sym10 *FakeCassetter) AssertFetchCalledWith(t CassetterTestingT, ctx context.Context, id string, progress func(int)) {
+	t.Helper()
+	var found_sym10 bool
+	for _, call_sym10 := range f_sym10.FetchCalls {
+		if reflect.DeepEqual(call_sym10.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym10.Parameters.Id, id) && reflect.DeepEqual(call_sym10.Parameters.Progress, progress) {
+			found_sym10 = true
+			break
+		}
+	}
+
+	if !found_sym10 {
+		t.Error("FakeCassetter.Fetch not called with expected parameters")
+	}
+}
+
+// FetchCalledOnceWith returns true if FakeCassetter.Fetch was called exactly once with the given values
+func (f_sym11 *FakeCassetter) FetchCalledOnceWith(ctx context.Context, id string, progress func(int)) bool {
+	var count_sym11 int
+	for _, call_sym11 := range f_sym11.FetchCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym11.Parameters.Id, id) && reflect.DeepEqual(call_sym11.Parameters.Progress, progress) {
+			count_sym11++
+		}
+	}
+
+	return count_sym11 == 1
+}
+
+// AssertFetchCalledOnceWith calls t.Error if FakeCassetter.Fetch was not called exactly once with the given values
+func (f_sym12 *FakeCassetter) AssertFetchCalledOnceWith(t CassetterTestingT, ctx context.Context, id string, progress func(int)) {
+	t.Helper()
+	var count_sym12 int
+	for _, call_sym12 := range f_sym12.FetchCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym12.Parameters.Id, id) && reflect.DeepEqual(call_sym12.Parameters.Progress, progress) {
+			count_sym12++
+		}
+	}
+
+	if count_sym12 != 1 {
+		t.Errorf("FakeCassetter.Fetch called %d times with expected parameters, expected one", count_sym12)
+	}
+}
+
+// FetchResultsForCall returns the result values for the first call to FakeCassetter.Fetch with the given values
+func (f_sym13 *FakeCassetter) FetchResultsForCall(ctx context.Context, id string, progress func(int)) (values []string, err error, found_sym13 bool) {
+	for _, call_sym13 := range f_sym13.FetchCalls {
+		if reflect.DeepEqual(call_sym13.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym13.Parameters.Id, id) && reflect.DeepEqual(call_sym13.Parameters.Progress, progress) {
+			values = call_sym13.Results.Values
+			err = call_sym13.Results.Err
+			found_sym13 = true
+			break
+		}
+	}
+
+	return
+}
+
+func (f_sym14 *FakeCassetter) Get(ctx context.Context, r io.Reader, cb Callback) (ident1 string, err error) {
+	if f_sym14.GetHook == nil {
+		panic("Cassetter.Get() called but FakeCassetter.GetHook is nil")
+	}
+
+	invocation_sym14 := new(CassetterGetInvocation)
+	f_sym14.GetCalls = append(f_sym14.GetCalls, invocation_sym14)
+
+	invocation_sym14.Parameters.Ctx = ctx
+	invocation_sym14.Parameters.R = r
+	invocation_sym14.Parameters.Cb = cb
+
+	ident1, err = f_sym14.GetHook(ctx, r, cb)
+
+	invocation_sym14.Results.Ident1 = ident1
+	invocation_sym14.Results.Err = err
+
+	return
+}
+
+// SetGetStub configures Cassetter.Get to always return the given values
+func (f_sym15 *FakeCassetter) SetGetStub(ident1 string, err error) {
+	f_sym15.GetHook = func(context.Context, io.Reader, Callback) (string, error) {
+		return ident1, err
+	}
+}
+
+// SetGetError configures Cassetter.Get to always return err and zero values.  Unlike a stub, a strict
+// fake does not require the method to be called.
+func (f_sym16 *FakeCassetter) SetGetError(err_sym16 error) {
+	f_sym16.GetHook = func(context.Context, io.Reader, Callback) (string, error) {
+		return "", err_sym16
+	}
+}
+
+// SetGetInvocation configures Cassetter.Get to return the given results when called with the given parameters
+// If no match is found for an invocation the result(s) of the fallback function are returned
+func (f_sym17 *FakeCassetter) SetGetInvocation(calls_sym17 []*CassetterGetInvocation, fallback_sym17 func() (string, error)) {
+	f_sym17.GetHook = func(ctx context.Context, r io.Reader, cb Callback) (ident1 string, err error) {
+		for _, call_sym17 := range calls_sym17 {
+			if reflect.DeepEqual(call_sym17.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym17.Parameters.R, r) && reflect.DeepEqual(call_sym17.Parameters.Cb, cb) {
+				ident1 = call_sym17.Results.Ident1
+				err = call_sym17.Results.Err
+
+				return
+			}
+		}
+
+		return fallback_sym17()
+	}
+}
+
+// GetCalled returns true if FakeCassetter.Get was called
+func (f *FakeCassetter) GetCalled() bool {
+	return len(f.GetCalls) != 0
+}
+
+// AssertGetCalled calls t.Error if FakeCassetter.Get was not called
+func (f *FakeCassetter) AssertGetCalled(t CassetterTestingT) {
+	t.Helper()
+	if len(f.GetCalls) == 0 {
+		t.Error("FakeCassetter.Get not called, expected at least one")
+	}
+}
+
+// GetNotCalled returns true if FakeCassetter.Get was not called
+func (f *FakeCassetter) GetNotCalled() bool {
+	return len(f.GetCalls) == 0
+}
+
+// AssertGetNotCalled calls t.Error if FakeCassetter.Get was called
+func (f *FakeCassetter) AssertGetNotCalled(t CassetterTestingT) {
+	t.Helper()
+	if len(f.GetCalls) != 0 {
+		t.Error("FakeCassetter.Get called, expected none")
+	}
+}
+
+// GetCalledOnce returns true if FakeCassetter.Get was called exactly once
+func (f *FakeCassetter) GetCalledOnce() bool {
+	return len(f.GetCalls) == 1
+}
+
+// AssertGetCalledOnce calls t.Error if FakeCassetter.Get was not called exactly once
+func (f *FakeCassetter) AssertGetCalledOnce(t CassetterTestingT) {
+	t.Helper()
+	if len(f.GetCalls) != 1 {
+		t.Errorf("FakeCassetter.Get called %d times, expected 1", len(f.GetCalls))
+	}
+}
+
+// GetCalledN returns true if FakeCassetter.Get was called at least n times
+func (f *FakeCassetter) GetCalledN(n int) bool {
+	return len(f.GetCalls) >= n
+}
+
+// AssertGetCalledN calls t.Error if FakeCassetter.Get was called less than n times
+func (f *FakeCassetter) AssertGetCalledN(t CassetterTestingT, n int) {
+	t.Helper()
+	if len(f.GetCalls) < n {
+		t.Errorf("FakeCassetter.Get called %d times, expected >= %d", len(f.GetCalls), n)
+	}
+}
+
+// GetCalledWith returns true if FakeCassetter.Get was called with the given values
+func (f_sym18 *FakeCassetter) GetCalledWith(ctx context.Context, r io.Reader, cb Callback) bool {
+	for _, call_sym18 := range f_sym18.GetCalls {
+		if reflect.DeepEqual(call_sym18.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym18.Parameters.R, r) && reflect.DeepEqual(call_sym18.Parameters.Cb, cb) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertGetCalledWith calls t.Error if FakeCassetter.Get was not called with the given values
+func (f_sym19 *FakeCassetter) AssertGetCalledWith(t CassetterTestingT, ctx context.Context, r io.Reader, cb Callback) {
+	t.Helper()
+	var found_sym19 bool
+	for _, call_sym19 := range f_sym19.GetCalls {
+		if reflect.DeepEqual(call_sym19.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym19.Parameters.R, r) && reflect.DeepEqual(call_sym19.Parameters.Cb, cb) {
+			found_sym19 = true
+			break
+		}
+	}
+
+	if !found_sym19 {
+		t.Error("FakeCassetter.Get not called with expected parameters")
+	}
+}
+
+// GetCalledOnceWith returns true if FakeCassetter.Get was called exactly once with the given values
+func (f_sym20 *FakeCassetter) GetCalledOnceWith(ctx context.Context, r io.Reader, cb Callback) bool {
+	var count_sym20 int
+	for _, call_sym20 := range f_sym20.GetCalls {
+		if reflect.DeepEqual(call_sym20.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym20.Parameters.R, r) && reflect.DeepEqual(call_sym20.Parameters.Cb, cb) {
+			count_sym20++
+		}
+	}
+
+	return count_sym20 == 1
+}
+
+// AssertGetCalledOnceWith calls t.Error if FakeCassetter.Get was not called exactly once with the given values
+func (f_sym21 *FakeCassetter) AssertGetCalledOnceWith(t CassetterTestingT, ctx context.Context, r io.Reader, cb Callback) {
+	t.Helper()
+	var count_sym21 int
+	for _, call_sym21 := range f_sym21.GetCalls {
+		if reflect.DeepEqual(call_sym21.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym21.Parameters.R, r) && reflect.DeepEqual(call_sym21.Parameters.Cb, cb) {
+			count_sym21++
+		}
+	}
+
+	if count_sym21 != 1 {
+		t.Errorf("FakeCassetter.Get called %d times with expected parameters, expected one", count_sym21)
+	}
+}
+
+// GetResultsForCall returns the result values for the first call to FakeCassetter.Get with the given values
+func (f_sym22 *FakeCassetter) GetResultsForCall(ctx context.Context, r io.Reader, cb Callback) (ident1 string, err error, found_sym22 bool) {
+	for _, call_sym22 := range f_sym22.GetCalls {
+		if reflect.DeepEqual(call_sym22.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym22.Parameters.R, r) && reflect.DeepEqual(call_sym22.Parameters.Cb, cb) {
+			ident1 = call_sym22.Results.Ident1
+			err = call_sym22.Results.Err
+			found_sym22 = true
+			break
+		}
+	}
+
+	return
+}
+
+func (f_sym23 *FakeCassetter) Watch(ctx context.Context, events chan<- string) (err error) {
+	if f_sym23.WatchHook == nil {
+		panic("Cassetter.Watch() called but FakeCassetter.WatchHook is nil")
+	}
+
+	invocation_sym23 := new(CassetterWatchInvocation)
+	f_sym23.WatchCalls = append(f_sym23.WatchCalls, invocation_sym23)
+
+	invocation_sym23.Parameters.Ctx = ctx
+	invocation_sym23.Parameters.Events = events
+
+	err = f_sym23.WatchHook(ctx, events)
+
+	invocation_sym23.Results.Err = err
+
+	return
+}
+
+// SetWatchStub configures Cassetter.Watch to always return the given values
+func (f_sym24 *FakeCassetter) SetWatchStub(err error) {
+	f_sym24.WatchHook = func(context.Context, chan<- string) error {
+		return err
+	}
+}
+
+// SetWatchError configures Cassetter.Watch to always return err and zero values.  Unlike a stub, a strict
+// fake does not require the method to be called.
+func (f_sym25 *FakeCassetter) SetWatchError(err_sym25 error) {
+	f_sym25.WatchHook = func(context.Context, chan<- string) error {
+		return err_sym25
+	}
+}
+
+// SetWatchInvocation configures Cassetter.Watch to return the given results when called with the given parameters
+// If no match is found for an invocation the result(s) of the fallback function are returned
+func (f_sym26 *FakeCassetter) SetWatchInvocation(calls_sym26 []*CassetterWatchInvocation, fallback_sym26 func() error) {
+	f_sym26.WatchHook = func(ctx context.Context, events chan<- string) (err error) {
+		for _, call_sym26 := range calls_sym26 {
+			if reflect.DeepEqual(call_sym26.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym26.Parameters.Events, events) {
+				err = call_sym26.Results.Err
+
+				return
+			}
+		}
+
+		return fallback_sym26()
+	}
+}
+
+// WatchCalled returns true if FakeCassetter.Watch was called
+func (f *FakeCassetter) WatchCalled() bool {
+	return len(f.WatchCalls) != 0
+}
+
+// AssertWatchCalled calls t.Error if FakeCassetter.Watch was not called
+func (f *FakeCassetter) AssertWatchCalled(t CassetterTestingT) {
+	t.Helper()
+	if len(f.WatchCalls) == 0 {
+		t.Error("FakeCassetter.Watch not called, expected at least one")
+	}
+}
+
+// WatchNotCalled returns true if FakeCassetter.Watch was not called
+func (f *FakeCassetter) WatchNotCalled() bool {
+	return len(f.WatchCalls) == 0
+}
+
+// AssertWatchNotCalled calls t.Error if FakeCassetter.Watch was called
+func (f *FakeCassetter) AssertWatchNotCalled(t CassetterTestingT) {
+	t.Helper()
+	if len(f.WatchCalls) != 0 {
+		t.Error("FakeCassetter.Watch called, expected none")
+	}
+}
+
+// WatchCalledOnce returns true if FakeCassetter.Watch was called exactly once
+func (f *FakeCassetter) WatchCalledOnce() bool {
+	return len(f.WatchCalls) == 1
+}
+
+// AssertWatchCalledOnce calls t.Error if FakeCassetter.Watch was not called exactly once
+func (f *FakeCassetter) AssertWatchCalledOnce(t CassetterTestingT) {
+	t.Helper()
+	if len(f.WatchCalls) != 1 {
+		t.Errorf("FakeCassetter.Watch called %d times, expected 1", len(f.WatchCalls))
+	}
+}
+
+// WatchCalledN returns true if FakeCassetter.Watch was called at least n times
+func (f *FakeCassetter) WatchCalledN(n int) bool {
+	return len(f.WatchCalls) >= n
+}
+
+// AssertWatchCalledN calls t.Error if FakeCassetter.Watch was called less than n times
+func (f *FakeCassetter) AssertWatchCalledN(t CassetterTestingT, n int) {
+	t.Helper()
+	if len(f.WatchCalls) < n {
+		t.Errorf("FakeCassetter.Watch called %d times, expected >= %d", len(f.WatchCalls), n)
+	}
+}
+
+// WatchCalledWith returns true if FakeCassetter.Watch was called with the given values
+func (f_sym27 *FakeCassetter) WatchCalledWith(ctx context.Context, events chan<- string) bool {
+	for _, call_sym27 := range f_sym27.WatchCalls {
+		if reflect.DeepEqual(call_sym27.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym27.Parameters.Events, events) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertWatchCalledWith calls t.Error if FakeCassetter.Watch was not called with the given values
+func (f_sym28 *FakeCassetter) AssertWatchCalledWith(t CassetterTestingT, ctx context.Context, events chan<- string) {
+	t.Helper()
+	var found_sym28 bool
+	for _, call_sym28 := range f_sym28.WatchCalls {
+		if reflect.DeepEqual(call_sym28.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym28.Parameters.Events, events) {
+			found_sym28 = true
+			break
+		}
+	}
+
+	if !found_sym28 {
+		t.Error("FakeCassetter.Watch not called with expected parameters")
+	}
+}
+
+// WatchCalledOnceWith returns true if FakeCassetter.Watch was called exactly once with the given values
+func (f_sym29 *FakeCassetter) WatchCalledOnceWith(ctx context.Context, events chan<- string) bool {
+	var count_sym29 int
+	for _, call_sym29 := range f_sym29.WatchCalls {
+		if reflect.DeepEqual(call_sym29.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym29.Parameters.Events, events) {
+			count_sym29++
+		}
+	}
+
+	return count_sym29 == 1
+}
+
+// AssertWatchCalledOnceWith calls t.Error if FakeCassetter.Watch was not called exactly once with the given values
+func (f_sym30 *FakeCassetter) AssertWatchCalledOnceWith(t CassetterTestingT, ctx context.Context, events chan<- string) {
+	t.Helper()
+	var count_sym30 int
+	for _, call_sym30 := range f_sym30.WatchCalls {
+		if reflect.DeepEqual(call_sym30.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym30.Parameters.Events, events) {
+			count_sym30++
+		}
+	}
+
+	if count_sym30 != 1 {
+		t.Errorf("FakeCassetter.Watch called %d times with expected parameters, expected one", count_sym30)
+	}
+}
+
+// WatchResultsForCall returns the result values for the first call to FakeCassetter.Watch with the given values
+func (f_sym31 *FakeCassetter) WatchResultsForCall(ctx context.Context, events chan<- string) (err error, found_sym31 bool) {
+	for _, call_sym31 := range f_sym31.WatchCalls {
+		if reflect.DeepEqual(call_sym31.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym31.Parameters.Events, events) {
+			err = call_sym31.Results.Err
+			found_sym31 = true
+			break
+		}
+	}
+
+	return
+}
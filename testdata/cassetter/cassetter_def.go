@@ -0,0 +1,14 @@
+package main
+
+import (
+	"context"
+	"io"
+)
+
+type Callback func(int)
+
+type Cassetter interface {
+	Fetch(ctx context.Context, id string, progress func(int)) (values []string, err error)
+	Get(ctx context.Context, r io.Reader, cb Callback) (string, error)
+	Watch(ctx context.Context, events chan<- string) error
+}
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/channeler -output=testdata/channeler/channeler.go Channeler".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/channeler -output=testdata/channeler/channeler.go Channeler".  DO NOT EDIT.
 
 package main
 
@@ -133,13 +133,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeChannel ...
+		// assert state of FakeChanneler ...
 		f.AssertChannelCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeChannel.
+unexpected calls are made to FakeChanneler.
 */
 type FakeChanneler struct {
 	ChannelHook          func(chan int) chan int
@@ -155,6 +155,8 @@ type FakeChanneler struct {
 	ChannelInterfaceCalls []*ChannelerChannelInterfaceInvocation
 }
 
+var _ Channeler = (*FakeChanneler)(nil)
+
 // NewFakeChannelerDefaultPanic returns an instance of FakeChanneler with all hooks configured to panic
 func NewFakeChannelerDefaultPanic() *FakeChanneler {
 	return &FakeChanneler{
@@ -228,6 +230,61 @@ func NewFakeChannelerDefaultError(t_sym2 ChannelerTestingT) *FakeChanneler {
 	}
 }
 
+// NewFakeChannelerDefaultZero returns an instance of FakeChanneler with all hooks configured to return zero values
+func NewFakeChannelerDefaultZero() *FakeChanneler {
+	return &FakeChanneler{
+		ChannelHook: func(chan int) (ident2 chan int) {
+			return
+		},
+		ChannelReceiveHook: func(<-chan int) (ident2 <-chan int) {
+			return
+		},
+		ChannelSendHook: func(chan<- int) (ident2 chan<- int) {
+			return
+		},
+		ChannelPointerHook: func(*chan int) (ident2 *chan int) {
+			return
+		},
+		ChannelInterfaceHook: func(chan interface{}) (ident2 chan interface{}) {
+			return
+		},
+	}
+}
+
+// NewFakeChannelerDefaultErr returns an instance of FakeChanneler with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeChannelerDefaultErr(err_sym3 error) *FakeChanneler {
+	return &FakeChanneler{
+		ChannelHook: func(chan int) (ident2 chan int) {
+			return
+		},
+		ChannelReceiveHook: func(<-chan int) (ident2 <-chan int) {
+			return
+		},
+		ChannelSendHook: func(chan<- int) (ident2 chan<- int) {
+			return
+		},
+		ChannelPointerHook: func(*chan int) (ident2 *chan int) {
+			return
+		},
+		ChannelInterfaceHook: func(chan interface{}) (ident2 chan interface{}) {
+			return
+		},
+	}
+}
+
+// NewFakeChannelerSpy returns an instance of FakeChanneler with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeChannelerSpy(real_sym4 Channeler) *FakeChanneler {
+	return &FakeChanneler{
+		ChannelHook:          real_sym4.Channel,
+		ChannelReceiveHook:   real_sym4.ChannelReceive,
+		ChannelSendHook:      real_sym4.ChannelSend,
+		ChannelPointerHook:   real_sym4.ChannelPointer,
+		ChannelInterfaceHook: real_sym4.ChannelInterface,
+	}
+}
+
 func (f *FakeChanneler) Reset() {
 	f.ChannelCalls = []*ChannelerChannelInvocation{}
 	f.ChannelReceiveCalls = []*ChannelerChannelReceiveInvocation{}
@@ -236,43 +293,43 @@ func (f *FakeChanneler) Reset() {
 	f.ChannelInterfaceCalls = []*ChannelerChannelInterfaceInvocation{}
 }
 
-func (f_sym3 *FakeChanneler) Channel(ident1 chan int) (ident2 chan int) {
-	if f_sym3.ChannelHook == nil {
+func (f_sym5 *FakeChanneler) Channel(ident1 chan int) (ident2 chan int) {
+	if f_sym5.ChannelHook == nil {
 		panic("Channeler.Channel() called but FakeChanneler.ChannelHook is nil")
 	}
 
-	invocation_sym3 := new(ChannelerChannelInvocation)
-	f_sym3.ChannelCalls = append(f_sym3.ChannelCalls, invocation_sym3)
+	invocation_sym5 := new(ChannelerChannelInvocation)
+	f_sym5.ChannelCalls = append(f_sym5.ChannelCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation_sym5.Parameters.Ident1 = ident1
 
-	ident2 = f_sym3.ChannelHook(ident1)
+	ident2 = f_sym5.ChannelHook(ident1)
 
-	invocation_sym3.Results.Ident2 = ident2
+	invocation_sym5.Results.Ident2 = ident2
 
 	return
 }
 
 // SetChannelStub configures Channeler.Channel to always return the given values
-func (f_sym4 *FakeChanneler) SetChannelStub(ident2 chan int) {
-	f_sym4.ChannelHook = func(chan int) chan int {
+func (f_sym6 *FakeChanneler) SetChannelStub(ident2 chan int) {
+	f_sym6.ChannelHook = func(chan int) chan int {
 		return ident2
 	}
 }
 
 // SetChannelInvocation configures Channeler.Channel to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeChanneler) SetChannelInvocation(calls_sym5 []*ChannelerChannelInvocation, fallback_sym5 func() chan int) {
-	f_sym5.ChannelHook = func(ident1 chan int) (ident2 chan int) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+func (f_sym7 *FakeChanneler) SetChannelInvocation(calls_sym7 []*ChannelerChannelInvocation, fallback_sym7 func() chan int) {
+	f_sym7.ChannelHook = func(ident1 chan int) (ident2 chan int) {
+		for _, call_sym7 := range calls_sym7 {
+			if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
+				ident2 = call_sym7.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback_sym7()
 	}
 }
 
@@ -329,9 +386,9 @@ func (f *FakeChanneler) AssertChannelCalledN(t ChannelerTestingT, n int) {
 }
 
 // ChannelCalledWith returns true if FakeChanneler.Channel was called with the given values
-func (f_sym6 *FakeChanneler) ChannelCalledWith(ident1 chan int) bool {
-	for _, call_sym6 := range f_sym6.ChannelCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+func (f_sym8 *FakeChanneler) ChannelCalledWith(ident1 chan int) bool {
+	for _, call_sym8 := range f_sym8.ChannelCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -340,54 +397,54 @@ func (f_sym6 *FakeChanneler) ChannelCalledWith(ident1 chan int) bool {
 }
 
 // AssertChannelCalledWith calls t.Error if FakeChanneler.Channel was not called with the given values
-func (f_sym7 *FakeChanneler) AssertChannelCalledWith(t ChannelerTestingT, ident1 chan int) {
+func (f_sym9 *FakeChanneler) AssertChannelCalledWith(t ChannelerTestingT, ident1 chan int) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.ChannelCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	var found_sym9 bool
+	for _, call_sym9 := range f_sym9.ChannelCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
+			found_sym9 = true
 			break
 		}
 	}
 
-	if !found_sym7 {
+	if !found_sym9 {
 		t.Error("FakeChanneler.Channel not called with expected parameters")
 	}
 }
 
 // ChannelCalledOnceWith returns true if FakeChanneler.Channel was called exactly once with the given values
-func (f_sym8 *FakeChanneler) ChannelCalledOnceWith(ident1 chan int) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.ChannelCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+func (f_sym10 *FakeChanneler) ChannelCalledOnceWith(ident1 chan int) bool {
+	var count_sym10 int
+	for _, call_sym10 := range f_sym10.ChannelCalls {
+		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
+			count_sym10++
 		}
 	}
 
-	return count_sym8 == 1
+	return count_sym10 == 1
 }
 
 // AssertChannelCalledOnceWith calls t.Error if FakeChanneler.Channel was not called exactly once with the given values
-func (f_sym9 *FakeChanneler) AssertChannelCalledOnceWith(t ChannelerTestingT, ident1 chan int) {
+func (f_sym11 *FakeChanneler) AssertChannelCalledOnceWith(t ChannelerTestingT, ident1 chan int) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.ChannelCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	var count_sym11 int
+	for _, call_sym11 := range f_sym11.ChannelCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.Ident1, ident1) {
+			count_sym11++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeChanneler.Channel called %d times with expected parameters, expected one", count_sym9)
+	if count_sym11 != 1 {
+		t.Errorf("FakeChanneler.Channel called %d times with expected parameters, expected one", count_sym11)
 	}
 }
 
 // ChannelResultsForCall returns the result values for the first call to FakeChanneler.Channel with the given values
-func (f_sym10 *FakeChanneler) ChannelResultsForCall(ident1 chan int) (ident2 chan int, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.ChannelCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+func (f_sym12 *FakeChanneler) ChannelResultsForCall(ident1 chan int) (ident2 chan int, found_sym12 bool) {
+	for _, call_sym12 := range f_sym12.ChannelCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.Ident1, ident1) {
+			ident2 = call_sym12.Results.Ident2
+			found_sym12 = true
 			break
 		}
 	}
@@ -395,43 +452,43 @@ func (f_sym10 *FakeChanneler) ChannelResultsForCall(ident1 chan int) (ident2 cha
 	return
 }
 
-func (f_sym11 *FakeChanneler) ChannelReceive(ident1 <-chan int) (ident2 <-chan int) {
-	if f_sym11.ChannelReceiveHook == nil {
+func (f_sym13 *FakeChanneler) ChannelReceive(ident1 <-chan int) (ident2 <-chan int) {
+	if f_sym13.ChannelReceiveHook == nil {
 		panic("Channeler.ChannelReceive() called but FakeChanneler.ChannelReceiveHook is nil")
 	}
 
-	invocation_sym11 := new(ChannelerChannelReceiveInvocation)
-	f_sym11.ChannelReceiveCalls = append(f_sym11.ChannelReceiveCalls, invocation_sym11)
+	invocation_sym13 := new(ChannelerChannelReceiveInvocation)
+	f_sym13.ChannelReceiveCalls = append(f_sym13.ChannelReceiveCalls, invocation_sym13)
 
-	invocation_sym11.Parameters.Ident1 = ident1
+	invocation_sym13.Parameters.Ident1 = ident1
 
-	ident2 = f_sym11.ChannelReceiveHook(ident1)
+	ident2 = f_sym13.ChannelReceiveHook(ident1)
 
-	invocation_sym11.Results.Ident2 = ident2
+	invocation_sym13.Results.Ident2 = ident2
 
 	return
 }
 
 // SetChannelReceiveStub configures Channeler.ChannelReceive to always return the given values
-func (f_sym12 *FakeChanneler) SetChannelReceiveStub(ident2 <-chan int) {
-	f_sym12.ChannelReceiveHook = func(<-chan int) <-chan int {
+func (f_sym14 *FakeChanneler) SetChannelReceiveStub(ident2 <-chan int) {
+	f_sym14.ChannelReceiveHook = func(<-chan int) <-chan int {
 		return ident2
 	}
 }
 
 // SetChannelReceiveInvocation configures Channeler.ChannelReceive to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeChanneler) SetChannelReceiveInvocation(calls_sym13 []*ChannelerChannelReceiveInvocation, fallback_sym13 func() <-chan int) {
-	f_sym13.ChannelReceiveHook = func(ident1 <-chan int) (ident2 <-chan int) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.Ident1, ident1) {
-				ident2 = call_sym13.Results.Ident2
+func (f_sym15 *FakeChanneler) SetChannelReceiveInvocation(calls_sym15 []*ChannelerChannelReceiveInvocation, fallback_sym15 func() <-chan int) {
+	f_sym15.ChannelReceiveHook = func(ident1 <-chan int) (ident2 <-chan int) {
+		for _, call_sym15 := range calls_sym15 {
+			if reflect.DeepEqual(call_sym15.Parameters.Ident1, ident1) {
+				ident2 = call_sym15.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback_sym15()
 	}
 }
 
@@ -488,9 +545,9 @@ func (f *FakeChanneler) AssertChannelReceiveCalledN(t ChannelerTestingT, n int)
 }
 
 // ChannelReceiveCalledWith returns true if FakeChanneler.ChannelReceive was called with the given values
-func (f_sym14 *FakeChanneler) ChannelReceiveCalledWith(ident1 <-chan int) bool {
-	for _, call_sym14 := range f_sym14.ChannelReceiveCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.Ident1, ident1) {
+func (f_sym16 *FakeChanneler) ChannelReceiveCalledWith(ident1 <-chan int) bool {
+	for _, call_sym16 := range f_sym16.ChannelReceiveCalls {
+		if reflect.DeepEqual(call_sym16.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -499,54 +556,54 @@ func (f_sym14 *FakeChanneler) ChannelReceiveCalledWith(ident1 <-chan int) bool {
 }
 
 // AssertChannelReceiveCalledWith calls t.Error if FakeChanneler.ChannelReceive was not called with the given values
-func (f_sym15 *FakeChanneler) AssertChannelReceiveCalledWith(t ChannelerTestingT, ident1 <-chan int) {
+func (f_sym17 *FakeChanneler) AssertChannelReceiveCalledWith(t ChannelerTestingT, ident1 <-chan int) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.ChannelReceiveCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.Ident1, ident1) {
-			found_sym15 = true
+	var found_sym17 bool
+	for _, call_sym17 := range f_sym17.ChannelReceiveCalls {
+		if reflect.DeepEqual(call_sym17.Parameters.Ident1, ident1) {
+			found_sym17 = true
 			break
 		}
 	}
 
-	if !found_sym15 {
+	if !found_sym17 {
 		t.Error("FakeChanneler.ChannelReceive not called with expected parameters")
 	}
 }
 
 // ChannelReceiveCalledOnceWith returns true if FakeChanneler.ChannelReceive was called exactly once with the given values
-func (f_sym16 *FakeChanneler) ChannelReceiveCalledOnceWith(ident1 <-chan int) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.ChannelReceiveCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.Ident1, ident1) {
-			count_sym16++
+func (f_sym18 *FakeChanneler) ChannelReceiveCalledOnceWith(ident1 <-chan int) bool {
+	var count_sym18 int
+	for _, call_sym18 := range f_sym18.ChannelReceiveCalls {
+		if reflect.DeepEqual(call_sym18.Parameters.Ident1, ident1) {
+			count_sym18++
 		}
 	}
 
-	return count_sym16 == 1
+	return count_sym18 == 1
 }
 
 // AssertChannelReceiveCalledOnceWith calls t.Error if FakeChanneler.ChannelReceive was not called exactly once with the given values
-func (f_sym17 *FakeChanneler) AssertChannelReceiveCalledOnceWith(t ChannelerTestingT, ident1 <-chan int) {
+func (f_sym19 *FakeChanneler) AssertChannelReceiveCalledOnceWith(t ChannelerTestingT, ident1 <-chan int) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.ChannelReceiveCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.Ident1, ident1) {
-			count_sym17++
+	var count_sym19 int
+	for _, call_sym19 := range f_sym19.ChannelReceiveCalls {
+		if reflect.DeepEqual(call_sym19.Parameters.Ident1, ident1) {
+			count_sym19++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeChanneler.ChannelReceive called %d times with expected parameters, expected one", count_sym17)
+	if count_sym19 != 1 {
+		t.Errorf("FakeChanneler.ChannelReceive called %d times with expected parameters, expected one", count_sym19)
 	}
 }
 
 // ChannelReceiveResultsForCall returns the result values for the first call to FakeChanneler.ChannelReceive with the given values
-func (f_sym18 *FakeChanneler) ChannelReceiveResultsForCall(ident1 <-chan int) (ident2 <-chan int, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.ChannelReceiveCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.Ident1, ident1) {
-			ident2 = call_sym18.Results.Ident2
-			found_sym18 = true
+func (f_sym20 *FakeChanneler) ChannelReceiveResultsForCall(ident1 <-chan int) (ident2 <-chan int, found_sym20 bool) {
+	for _, call_sym20 := range f_sym20.ChannelReceiveCalls {
+		if reflect.DeepEqual(call_sym20.Parameters.Ident1, ident1) {
+			ident2 = call_sym20.Results.Ident2
+			found_sym20 = true
 			break
 		}
 	}
@@ -554,43 +611,43 @@ func (f_sym18 *FakeChanneler) ChannelReceiveResultsForCall(ident1 <-chan int) (i
 	return
 }
 
-func (f_sym19 *FakeChanneler) ChannelSend(ident1 chan<- int) (ident2 chan<- int) {
-	if f_sym19.ChannelSendHook == nil {
+func (f_sym21 *FakeChanneler) ChannelSend(ident1 chan<- int) (ident2 chan<- int) {
+	if f_sym21.ChannelSendHook == nil {
 		panic("Channeler.ChannelSend() called but FakeChanneler.ChannelSendHook is nil")
 	}
 
-	invocation_sym19 := new(ChannelerChannelSendInvocation)
-	f_sym19.ChannelSendCalls = append(f_sym19.ChannelSendCalls, invocation_sym19)
+	invocation_sym21 := new(ChannelerChannelSendInvocation)
+	f_sym21.ChannelSendCalls = append(f_sym21.ChannelSendCalls, invocation_sym21)
 
-	invocation_sym19.Parameters.Ident1 = ident1
+	invocation_sym21.Parameters.Ident1 = ident1
 
-	ident2 = f_sym19.ChannelSendHook(ident1)
+	ident2 = f_sym21.ChannelSendHook(ident1)
 
-	invocation_sym19.Results.Ident2 = ident2
+	invocation_sym21.Results.Ident2 = ident2
 
 	return
 }
 
 // SetChannelSendStub configures Channeler.ChannelSend to always return the given values
-func (f_sym20 *FakeChanneler) SetChannelSendStub(ident2 chan<- int) {
-	f_sym20.ChannelSendHook = func(chan<- int) chan<- int {
+func (f_sym22 *FakeChanneler) SetChannelSendStub(ident2 chan<- int) {
+	f_sym22.ChannelSendHook = func(chan<- int) chan<- int {
 		return ident2
 	}
 }
 
 // SetChannelSendInvocation configures Channeler.ChannelSend to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym21 *FakeChanneler) SetChannelSendInvocation(calls_sym21 []*ChannelerChannelSendInvocation, fallback_sym21 func() chan<- int) {
-	f_sym21.ChannelSendHook = func(ident1 chan<- int) (ident2 chan<- int) {
-		for _, call_sym21 := range calls_sym21 {
-			if reflect.DeepEqual(call_sym21.Parameters.Ident1, ident1) {
-				ident2 = call_sym21.Results.Ident2
+func (f_sym23 *FakeChanneler) SetChannelSendInvocation(calls_sym23 []*ChannelerChannelSendInvocation, fallback_sym23 func() chan<- int) {
+	f_sym23.ChannelSendHook = func(ident1 chan<- int) (ident2 chan<- int) {
+		for _, call_sym23 := range calls_sym23 {
+			if reflect.DeepEqual(call_sym23.Parameters.Ident1, ident1) {
+				ident2 = call_sym23.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym21()
+		return fallback_sym23()
 	}
 }
 
@@ -647,9 +704,9 @@ func (f *FakeChanneler) AssertChannelSendCalledN(t ChannelerTestingT, n int) {
 }
 
 // ChannelSendCalledWith returns true if FakeChanneler.ChannelSend was called with the given values
-func (f_sym22 *FakeChanneler) ChannelSendCalledWith(ident1 chan<- int) bool {
-	for _, call_sym22 := range f_sym22.ChannelSendCalls {
-		if reflect.DeepEqual(call_sym22.Parameters.Ident1, ident1) {
+func (f_sym24 *FakeChanneler) ChannelSendCalledWith(ident1 chan<- int) bool {
+	for _, call_sym24 := range f_sym24.ChannelSendCalls {
+		if reflect.DeepEqual(call_sym24.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -658,54 +715,54 @@ func (f_sym22 *FakeChanneler) ChannelSendCalledWith(ident1 chan<- int) bool {
 }
 
 // AssertChannelSendCalledWith calls t.Error if FakeChanneler.ChannelSend was not called with the given values
-func (f_sym23 *FakeChanneler) AssertChannelSendCalledWith(t ChannelerTestingT, ident1 chan<- int) {
+func (f_sym25 *FakeChanneler) AssertChannelSendCalledWith(t ChannelerTestingT, ident1 chan<- int) {
 	t.Helper()
-	var found_sym23 bool
-	for _, call_sym23 := range f_sym23.ChannelSendCalls {
-		if reflect.DeepEqual(call_sym23.Parameters.Ident1, ident1) {
-			found_sym23 = true
+	var found_sym25 bool
+	for _, call_sym25 := range f_sym25.ChannelSendCalls {
+		if reflect.DeepEqual(call_sym25.Parameters.Ident1, ident1) {
+			found_sym25 = true
 			break
 		}
 	}
 
-	if !found_sym23 {
+	if !found_sym25 {
 		t.Error("FakeChanneler.ChannelSend not called with expected parameters")
 	}
 }
 
 // ChannelSendCalledOnceWith returns true if FakeChanneler.ChannelSend was called exactly once with the given values
-func (f_sym24 *FakeChanneler) ChannelSendCalledOnceWith(ident1 chan<- int) bool {
-	var count_sym24 int
-	for _, call_sym24 := range f_sym24.ChannelSendCalls {
-		if reflect.DeepEqual(call_sym24.Parameters.Ident1, ident1) {
-			count_sym24++
+func (f_sym26 *FakeChanneler) ChannelSendCalledOnceWith(ident1 chan<- int) bool {
+	var count_sym26 int
+	for _, call_sym26 := range f_sym26.ChannelSendCalls {
+		if reflect.DeepEqual(call_sym26.Parameters.Ident1, ident1) {
+			count_sym26++
 		}
 	}
 
-	return count_sym24 == 1
+	return count_sym26 == 1
 }
 
 // AssertChannelSendCalledOnceWith calls t.Error if FakeChanneler.ChannelSend was not called exactly once with the given values
-func (f_sym25 *FakeChanneler) AssertChannelSendCalledOnceWith(t ChannelerTestingT, ident1 chan<- int) {
+func (f_sym27 *FakeChanneler) AssertChannelSendCalledOnceWith(t ChannelerTestingT, ident1 chan<- int) {
 	t.Helper()
-	var count_sym25 int
-	for _, call_sym25 := range f_sym25.ChannelSendCalls {
-		if reflect.DeepEqual(call_sym25.Parameters.Ident1, ident1) {
-			count_sym25++
+	var count_sym27 int
+	for _, call_sym27 := range f_sym27.ChannelSendCalls {
+		if reflect.DeepEqual(call_sym27.Parameters.Ident1, ident1) {
+			count_sym27++
 		}
 	}
 
-	if count_sym25 != 1 {
-		t.Errorf("FakeChanneler.ChannelSend called %d times with expected parameters, expected one", count_sym25)
+	if count_sym27 != 1 {
+		t.Errorf("FakeChanneler.ChannelSend called %d times with expected parameters, expected one", count_sym27)
 	}
 }
 
 // ChannelSendResultsForCall returns the result values for the first call to FakeChanneler.ChannelSend with the given values
-func (f_sym26 *FakeChanneler) ChannelSendResultsForCall(ident1 chan<- int) (ident2 chan<- int, found_sym26 bool) {
-	for _, call_sym26 := range f_sym26.ChannelSendCalls {
-		if reflect.DeepEqual(call_sym26.Parameters.Ident1, ident1) {
-			ident2 = call_sym26.Results.Ident2
-			found_sym26 = true
+func (f_sym28 *FakeChanneler) ChannelSendResultsForCall(ident1 chan<- int) (ident2 chan<- int, found_sym28 bool) {
+	for _, call_sym28 := range f_sym28.ChannelSendCalls {
+		if reflect.DeepEqual(call_sym28.Parameters.Ident1, ident1) {
+			ident2 = call_sym28.Results.Ident2
+			found_sym28 = true
 			break
 		}
 	}
@@ -713,43 +770,43 @@ func (f_sym26 *FakeChanneler) ChannelSendResultsForCall(ident1 chan<- int) (iden
 	return
 }
 
-func (f_sym27 *FakeChanneler) ChannelPointer(ident1 *chan int) (ident2 *chan int) {
-	if f_sym27.ChannelPointerHook == nil {
+func (f_sym29 *FakeChanneler) ChannelPointer(ident1 *chan int) (ident2 *chan int) {
+	if f_sym29.ChannelPointerHook == nil {
 		panic("Channeler.ChannelPointer() called but FakeChanneler.ChannelPointerHook is nil")
 	}
 
-	invocation_sym27 := new(ChannelerChannelPointerInvocation)
-	f_sym27.ChannelPointerCalls = append(f_sym27.ChannelPointerCalls, invocation_sym27)
+	invocation_sym29 := new(ChannelerChannelPointerInvocation)
+	f_sym29.ChannelPointerCalls = append(f_sym29.ChannelPointerCalls, invocation_sym29)
 
-	invocation_sym27.Parameters.Ident1 = ident1
+	invocation_sym29.Parameters.Ident1 = ident1
 
-	ident2 = f_sym27.ChannelPointerHook(ident1)
+	ident2 = f_sym29.ChannelPointerHook(ident1)
 
-	invocation_sym27.Results.Ident2 = ident2
+	invocation_sym29.Results.Ident2 = ident2
 
 	return
 }
 
 // SetChannelPointerStub configures Channeler.ChannelPointer to always return the given values
-func (f_sym28 *FakeChanneler) SetChannelPointerStub(ident2 *chan int) {
-	f_sym28.ChannelPointerHook = func(*chan int) *chan int {
+func (f_sym30 *FakeChanneler) SetChannelPointerStub(ident2 *chan int) {
+	f_sym30.ChannelPointerHook = func(*chan int) *chan int {
 		return ident2
 	}
 }
 
 // SetChannelPointerInvocation configures Channeler.ChannelPointer to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym29 *FakeChanneler) SetChannelPointerInvocation(calls_sym29 []*ChannelerChannelPointerInvocation, fallback_sym29 func() *chan int) {
-	f_sym29.ChannelPointerHook = func(ident1 *chan int) (ident2 *chan int) {
-		for _, call_sym29 := range calls_sym29 {
-			if reflect.DeepEqual(call_sym29.Parameters.Ident1, ident1) {
-				ident2 = call_sym29.Results.Ident2
+func (f_sym31 *FakeChanneler) SetChannelPointerInvocation(calls_sym31 []*ChannelerChannelPointerInvocation, fallback_sym31 func() *chan int) {
+	f_sym31.ChannelPointerHook = func(ident1 *chan int) (ident2 *chan int) {
+		for _, call_sym31 := range calls_sym31 {
+			if reflect.DeepEqual(call_sym31.Parameters.Ident1, ident1) {
+				ident2 = call_sym31.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym29()
+		return fallback_sym31()
 	}
 }
 
@@ -806,9 +863,9 @@ func (f *FakeChanneler) AssertChannelPointerCalledN(t ChannelerTestingT, n int)
 }
 
 // ChannelPointerCalledWith returns true if FakeChanneler.ChannelPointer was called with the given values
-func (f_sym30 *FakeChanneler) ChannelPointerCalledWith(ident1 *chan int) bool {
-	for _, call_sym30 := range f_sym30.ChannelPointerCalls {
-		if reflect.DeepEqual(call_sym30.Parameters.Ident1, ident1) {
+func (f_sym32 *FakeChanneler) ChannelPointerCalledWith(ident1 *chan int) bool {
+	for _, call_sym32 := range f_sym32.ChannelPointerCalls {
+		if reflect.DeepEqual(call_sym32.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -817,54 +874,54 @@ func (f_sym30 *FakeChanneler) ChannelPointerCalledWith(ident1 *chan int) bool {
 }
 
 // AssertChannelPointerCalledWith calls t.Error if FakeChanneler.ChannelPointer was not called with the given values
-func (f_sym31 *FakeChanneler) AssertChannelPointerCalledWith(t ChannelerTestingT, ident1 *chan int) {
+func (f_sym33 *FakeChanneler) AssertChannelPointerCalledWith(t ChannelerTestingT, ident1 *chan int) {
 	t.Helper()
-	var found_sym31 bool
-	for _, call_sym31 := range f_sym31.ChannelPointerCalls {
-		if reflect.DeepEqual(call_sym31.Parameters.Ident1, ident1) {
-			found_sym31 = true
+	var found_sym33 bool
+	for _, call_sym33 := range f_sym33.ChannelPointerCalls {
+		if reflect.DeepEqual(call_sym33.Parameters.Ident1, ident1) {
+			found_sym33 = true
 			break
 		}
 	}
 
-	if !found_sym31 {
+	if !found_sym33 {
 		t.Error("FakeChanneler.ChannelPointer not called with expected parameters")
 	}
 }
 
 // ChannelPointerCalledOnceWith returns true if FakeChanneler.ChannelPointer was called exactly once with the given values
-func (f_sym32 *FakeChanneler) ChannelPointerCalledOnceWith(ident1 *chan int) bool {
-	var count_sym32 int
-	for _, call_sym32 := range f_sym32.ChannelPointerCalls {
-		if reflect.DeepEqual(call_sym32.Parameters.Ident1, ident1) {
-			count_sym32++
+func (f_sym34 *FakeChanneler) ChannelPointerCalledOnceWith(ident1 *chan int) bool {
+	var count_sym34 int
+	for _, call_sym34 := range f_sym34.ChannelPointerCalls {
+		if reflect.DeepEqual(call_sym34.Parameters.Ident1, ident1) {
+			count_sym34++
 		}
 	}
 
-	return count_sym32 == 1
+	return count_sym34 == 1
 }
 
 // AssertChannelPointerCalledOnceWith calls t.Error if FakeChanneler.ChannelPointer was not called exactly once with the given values
-func (f_sym33 *FakeChanneler) AssertChannelPointerCalledOnceWith(t ChannelerTestingT, ident1 *chan int) {
+func (f_sym35 *FakeChanneler) AssertChannelPointerCalledOnceWith(t ChannelerTestingT, ident1 *chan int) {
 	t.Helper()
-	var count_sym33 int
-	for _, call_sym33 := range f_sym33.ChannelPointerCalls {
-		if reflect.DeepEqual(call_sym33.Parameters.Ident1, ident1) {
-			count_sym33++
+	var count_sym35 int
+	for _, call_sym35 := range f_sym35.ChannelPointerCalls {
+		if reflect.DeepEqual(call_sym35.Parameters.Ident1, ident1) {
+			count_sym35++
 		}
 	}
 
-	if count_sym33 != 1 {
-		t.Errorf("FakeChanneler.ChannelPointer called %d times with expected parameters, expected one", count_sym33)
+	if count_sym35 != 1 {
+		t.Errorf("FakeChanneler.ChannelPointer called %d times with expected parameters, expected one", count_sym35)
 	}
 }
 
 // ChannelPointerResultsForCall returns the result values for the first call to FakeChanneler.ChannelPointer with the given values
-func (f_sym34 *FakeChanneler) ChannelPointerResultsForCall(ident1 *chan int) (ident2 *chan int, found_sym34 bool) {
-	for _, call_sym34 := range f_sym34.ChannelPointerCalls {
-		if reflect.DeepEqual(call_sym34.Parameters.Ident1, ident1) {
-			ident2 = call_sym34.Results.Ident2
-			found_sym34 = true
+func (f_sym36 *FakeChanneler) ChannelPointerResultsForCall(ident1 *chan int) (ident2 *chan int, found_sym36 bool) {
+	for _, call_sym36 := range f_sym36.ChannelPointerCalls {
+		if reflect.DeepEqual(call_sym36.Parameters.Ident1, ident1) {
+			ident2 = call_sym36.Results.Ident2
+			found_sym36 = true
 			break
 		}
 	}
@@ -872,43 +929,43 @@ func (f_sym34 *FakeChanneler) ChannelPointerResultsForCall(ident1 *chan int) (id
 	return
 }
 
-func (f_sym35 *FakeChanneler) ChannelInterface(ident1 chan interface{}) (ident2 chan interface{}) {
-	if f_sym35.ChannelInterfaceHook == nil {
+func (f_sym37 *FakeChanneler) ChannelInterface(ident1 chan interface{}) (ident2 chan interface{}) {
+	if f_sym37.ChannelInterfaceHook == nil {
 		panic("Channeler.ChannelInterface() called but FakeChanneler.ChannelInterfaceHook is nil")
 	}
 
-	invocation_sym35 := new(ChannelerChannelInterfaceInvocation)
-	f_sym35.ChannelInterfaceCalls = append(f_sym35.ChannelInterfaceCalls, invocation_sym35)
+	invocation_sym37 := new(ChannelerChannelInterfaceInvocation)
+	f_sym37.ChannelInterfaceCalls = append(f_sym37.ChannelInterfaceCalls, invocation_sym37)
 
-	invocation_sym35.Parameters.Ident1 = ident1
+	invocation_sym37.Parameters.Ident1 = ident1
 
-	ident2 = f_sym35.ChannelInterfaceHook(ident1)
+	ident2 = f_sym37.ChannelInterfaceHook(ident1)
 
-	invocation_sym35.Results.Ident2 = ident2
+	invocation_sym37.Results.Ident2 = ident2
 
 	return
 }
 
 // SetChannelInterfaceStub configures Channeler.ChannelInterface to always return the given values
-func (f_sym36 *FakeChanneler) SetChannelInterfaceStub(ident2 chan interface{}) {
-	f_sym36.ChannelInterfaceHook = func(chan interface{}) chan interface{} {
+func (f_sym38 *FakeChanneler) SetChannelInterfaceStub(ident2 chan interface{}) {
+	f_sym38.ChannelInterfaceHook = func(chan interface{}) chan interface{} {
 		return ident2
 	}
 }
 
 // SetChannelInterfaceInvocation configures Channeler.ChannelInterface to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym37 *FakeChanneler) SetChannelInterfaceInvocation(calls_sym37 []*ChannelerChannelInterfaceInvocation, fallback_sym37 func() chan interface{}) {
-	f_sym37.ChannelInterfaceHook = func(ident1 chan interface{}) (ident2 chan interface{}) {
-		for _, call_sym37 := range calls_sym37 {
-			if reflect.DeepEqual(call_sym37.Parameters.Ident1, ident1) {
-				ident2 = call_sym37.Results.Ident2
+func (f_sym39 *FakeChanneler) SetChannelInterfaceInvocation(calls_sym39 []*ChannelerChannelInterfaceInvocation, fallback_sym39 func() chan interface{}) {
+	f_sym39.ChannelInterfaceHook = func(ident1 chan interface{}) (ident2 chan interface{}) {
+		for _, call_sym39 := range calls_sym39 {
+			if reflect.DeepEqual(call_sym39.Parameters.Ident1, ident1) {
+				ident2 = call_sym39.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym37()
+		return fallback_sym39()
 	}
 }
 
@@ -965,9 +1022,9 @@ func (f *FakeChanneler) AssertChannelInterfaceCalledN(t ChannelerTestingT, n int
 }
 
 // ChannelInterfaceCalledWith returns true if FakeChanneler.ChannelInterface was called with the given values
-func (f_sym38 *FakeChanneler) ChannelInterfaceCalledWith(ident1 chan interface{}) bool {
-	for _, call_sym38 := range f_sym38.ChannelInterfaceCalls {
-		if reflect.DeepEqual(call_sym38.Parameters.Ident1, ident1) {
+func (f_sym40 *FakeChanneler) ChannelInterfaceCalledWith(ident1 chan interface{}) bool {
+	for _, call_sym40 := range f_sym40.ChannelInterfaceCalls {
+		if reflect.DeepEqual(call_sym40.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -976,54 +1033,54 @@ func (f_sym38 *FakeChanneler) ChannelInterfaceCalledWith(ident1 chan interface{}
 }
 
 // AssertChannelInterfaceCalledWith calls t.Error if FakeChanneler.ChannelInterface was not called with the given values
-func (f_sym39 *FakeChanneler) AssertChannelInterfaceCalledWith(t ChannelerTestingT, ident1 chan interface{}) {
+func (f_sym41 *FakeChanneler) AssertChannelInterfaceCalledWith(t ChannelerTestingT, ident1 chan interface{}) {
 	t.Helper()
-	var found_sym39 bool
-	for _, call_sym39 := range f_sym39.ChannelInterfaceCalls {
-		if reflect.DeepEqual(call_sym39.Parameters.Ident1, ident1) {
-			found_sym39 = true
+	var found_sym41 bool
+	for _, call_sym41 := range f_sym41.ChannelInterfaceCalls {
+		if reflect.DeepEqual(call_sym41.Parameters.Ident1, ident1) {
+			found_sym41 = true
 			break
 		}
 	}
 
-	if !found_sym39 {
+	if !found_sym41 {
 		t.Error("FakeChanneler.ChannelInterface not called with expected parameters")
 	}
 }
 
 // ChannelInterfaceCalledOnceWith returns true if FakeChanneler.ChannelInterface was called exactly once with the given values
-func (f_sym40 *FakeChanneler) ChannelInterfaceCalledOnceWith(ident1 chan interface{}) bool {
-	var count_sym40 int
-	for _, call_sym40 := range f_sym40.ChannelInterfaceCalls {
-		if reflect.DeepEqual(call_sym40.Parameters.Ident1, ident1) {
-			count_sym40++
+func (f_sym42 *FakeChanneler) ChannelInterfaceCalledOnceWith(ident1 chan interface{}) bool {
+	var count_sym42 int
+	for _, call_sym42 := range f_sym42.ChannelInterfaceCalls {
+		if reflect.DeepEqual(call_sym42.Parameters.Ident1, ident1) {
+			count_sym42++
 		}
 	}
 
-	return count_sym40 == 1
+	return count_sym42 == 1
 }
 
 // AssertChannelInterfaceCalledOnceWith calls t.Error if FakeChanneler.ChannelInterface was not called exactly once with the given values
-func (f_sym41 *FakeChanneler) AssertChannelInterfaceCalledOnceWith(t ChannelerTestingT, ident1 chan interface{}) {
+func (f_sym43 *FakeChanneler) AssertChannelInterfaceCalledOnceWith(t ChannelerTestingT, ident1 chan interface{}) {
 	t.Helper()
-	var count_sym41 int
-	for _, call_sym41 := range f_sym41.ChannelInterfaceCalls {
-		if reflect.DeepEqual(call_sym41.Parameters.Ident1, ident1) {
-			count_sym41++
+	var count_sym43 int
+	for _, call_sym43 := range f_sym43.ChannelInterfaceCalls {
+		if reflect.DeepEqual(call_sym43.Parameters.Ident1, ident1) {
+			count_sym43++
 		}
 	}
 
-	if count_sym41 != 1 {
-		t.Errorf("FakeChanneler.ChannelInterface called %d times with expected parameters, expected one", count_sym41)
+	if count_sym43 != 1 {
+		t.Errorf("FakeChanneler.ChannelInterface called %d times with expected parameters, expected one", count_sym43)
 	}
 }
 
 // ChannelInterfaceResultsForCall returns the result values for the first call to FakeChanneler.ChannelInterface with the given values
-func (f_sym42 *FakeChanneler) ChannelInterfaceResultsForCall(ident1 chan interface{}) (ident2 chan interface{}, found_sym42 bool) {
-	for _, call_sym42 := range f_sym42.ChannelInterfaceCalls {
-		if reflect.DeepEqual(call_sym42.Parameters.Ident1, ident1) {
-			ident2 = call_sym42.Results.Ident2
-			found_sym42 = true
+func (f_sym44 *FakeChanneler) ChannelInterfaceResultsForCall(ident1 chan interface{}) (ident2 chan interface{}, found_sym44 bool) {
+	for _, call_sym44 := range f_sym44.ChannelInterfaceCalls {
+		if reflect.DeepEqual(call_sym44.Parameters.Ident1, ident1) {
+			ident2 = call_sym44.Results.Ident2
+			found_sym44 = true
 			break
 		}
 	}
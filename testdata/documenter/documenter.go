@@ -0,0 +1,633 @@
+// Code generated by "charlatan -dir=testdata/documenter -output=testdata/documenter/documenter.go Documenter".  DO NOT EDIT.
+
+package main
+
+import "reflect"
+
+// DocumenterCurrentInvocation represents a single call of FakeDocumenter.Current
+type DocumenterCurrentInvocation struct {
+	Results struct {
+		Ident1 int
+	}
+}
+
+// DocumenterUpdateInvocation represents a single call of FakeDocumenter.Update
+//
+// Deprecated: use Replace, Update ignores the context.
+type DocumenterUpdateInvocation struct {
+	Parameters struct {
+		Value int
+	}
+	Results struct {
+		Err error
+	}
+}
+
+// NewDocumenterUpdateInvocation creates a new instance of DocumenterUpdateInvocation
+//
+// Deprecated: use Replace, Update ignores the context.
+func NewDocumenterUpdateInvocation(value int, err error) *DocumenterUpdateInvocation {
+	invocation := new(DocumenterUpdateInvocation)
+
+	invocation.Parameters.Value = value
+
+	invocation.Results.Err = err
+
+	return invocation
+}
+
+// DocumenterReplaceInvocation represents a single call of FakeDocumenter.Replace
+type DocumenterReplaceInvocation struct {
+	Parameters struct {
+		Value int
+	}
+	Results struct {
+		Err error
+	}
+}
+
+// NewDocumenterReplaceInvocation creates a new instance of DocumenterReplaceInvocation
+func NewDocumenterReplaceInvocation(value int, err error) *DocumenterReplaceInvocation {
+	invocation := new(DocumenterReplaceInvocation)
+
+	invocation.Parameters.Value = value
+
+	invocation.Results.Err = err
+
+	return invocation
+}
+
+// DocumenterTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
+type DocumenterTestingT interface {
+	Error(...interface{})
+	Errorf(string, ...interface{})
+	Fatal(...interface{})
+	Helper()
+}
+
+/*
+FakeDocumenter is a mock implementation of Documenter for testing.
+Use it in your tests as in this example:
+
+	package example
+
+	func TestWithDocumenter(t *testing.T) {
+		f := &main.FakeDocumenter{
+			CurrentHook: func() (ident1 int) {
+				// ensure parameters meet expectations, signal errors using t, etc
+				return
+			},
+		}
+
+		// test code goes here ...
+
+		// assert state of FakeDocumenter ...
+		f.AssertCurrentCalledOnce(t)
+	}
+
+Create anonymous function implementations for only those interface methods that
+should be called in the code under test.  This will force a panic if any
+unexpected calls are made to FakeDocumenter.
+*/
+type FakeDocumenter struct {
+	// Current returns the current value.
+	//
+	// The value is never negative.
+	CurrentHook func() int
+	// Update replaces the value.
+	//
+	// Deprecated: use Replace, Update ignores the context.
+	UpdateHook  func(int) error
+	ReplaceHook func(int) error
+
+	CurrentCalls []*DocumenterCurrentInvocation
+	UpdateCalls  []*DocumenterUpdateInvocation
+	ReplaceCalls []*DocumenterReplaceInvocation
+}
+
+var _ Documenter = (*FakeDocumenter)(nil)
+
+// NewFakeDocumenterDefaultPanic returns an instance of FakeDocumenter with all hooks configured to panic
+func NewFakeDocumenterDefaultPanic() *FakeDocumenter {
+	return &FakeDocumenter{
+		CurrentHook: func() (ident1 int) {
+			panic("Unexpected call to Documenter.Current")
+		},
+		UpdateHook: func(int) (err error) {
+			panic("Unexpected call to Documenter.Update")
+		},
+		ReplaceHook: func(int) (err error) {
+			panic("Unexpected call to Documenter.Replace")
+		},
+	}
+}
+
+// NewFakeDocumenterDefaultFatal returns an instance of FakeDocumenter with all hooks configured to call t.Fatal
+func NewFakeDocumenterDefaultFatal(t_sym1 DocumenterTestingT) *FakeDocumenter {
+	return &FakeDocumenter{
+		CurrentHook: func() (ident1 int) {
+			t_sym1.Fatal("Unexpected call to Documenter.Current")
+			return
+		},
+		UpdateHook: func(int) (err error) {
+			t_sym1.Fatal("Unexpected call to Documenter.Update")
+			return
+		},
+		ReplaceHook: func(int) (err error) {
+			t_sym1.Fatal("Unexpected call to Documenter.Replace")
+			return
+		},
+	}
+}
+
+// NewFakeDocumenterDefaultError returns an instance of FakeDocumenter with all hooks configured to call t.Error
+func NewFakeDocumenterDefaultError(t_sym2 DocumenterTestingT) *FakeDocumenter {
+	return &FakeDocumenter{
+		CurrentHook: func() (ident1 int) {
+			t_sym2.Error("Unexpected call to Documenter.Current")
+			return
+		},
+		UpdateHook: func(int) (err error) {
+			t_sym2.Error("Unexpected call to Documenter.Update")
+			return
+		},
+		ReplaceHook: func(int) (err error) {
+			t_sym2.Error("Unexpected call to Documenter.Replace")
+			return
+		},
+	}
+}
+
+// NewFakeDocumenterDefaultZero returns an instance of FakeDocumenter with all hooks configured to return zero values
+func NewFakeDocumenterDefaultZero() *FakeDocumenter {
+	return &FakeDocumenter{
+		CurrentHook: func() (ident1 int) {
+			return
+		},
+		UpdateHook: func(int) (err error) {
+			return
+		},
+		ReplaceHook: func(int) (err error) {
+			return
+		},
+	}
+}
+
+// NewFakeDocumenterDefaultErr returns an instance of FakeDocumenter with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeDocumenterDefaultErr(err_sym3 error) *FakeDocumenter {
+	return &FakeDocumenter{
+		CurrentHook: func() (ident1 int) {
+			return
+		},
+		UpdateHook: func(int) (err error) {
+			return err_sym3
+		},
+		ReplaceHook: func(int) (err error) {
+			return err_sym3
+		},
+	}
+}
+
+// NewFakeDocumenterSpy returns an instance of FakeDocumenter with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeDocumenterSpy(real_sym4 Documenter) *FakeDocumenter {
+	return &FakeDocumenter{
+		CurrentHook: real_sym4.Current,
+		UpdateHook:  real_sym4.Update,
+		ReplaceHook: real_sym4.Replace,
+	}
+}
+
+func (f *FakeDocumenter) Reset() {
+	f.CurrentCalls = []*DocumenterCurrentInvocation{}
+	f.UpdateCalls = []*DocumenterUpdateInvocation{}
+	f.ReplaceCalls = []*DocumenterReplaceInvocation{}
+}
+
+// FailAll configures every method of Documenter whose last result is an error to return err and zero values, see
+// Set*Error.  A strict fake does not require the methods to be called.
+func (f *FakeDocumenter) FailAll(err error) {
+	f.SetUpdateError(err)
+	f.SetReplaceError(err)
+}
+
+// Current returns the current value.
+//
+// The value is never negative.
+func (f_sym5 *FakeDocumenter) Current() (ident1 int) {
+	if f_sym5.CurrentHook == nil {
+		panic("Documenter.Current() called but FakeDocumenter.CurrentHook is nil")
+	}
+
+	invocation_sym5 := new(DocumenterCurrentInvocation)
+	f_sym5.CurrentCalls = append(f_sym5.CurrentCalls, invocation_sym5)
+
+	ident1 = f_sym5.CurrentHook()
+
+	invocation_sym5.Results.Ident1 = ident1
+
+	return
+}
+
+// SetCurrentStub configures Documenter.Current to always return the given values
+func (f_sym6 *FakeDocumenter) SetCurrentStub(ident1 int) {
+	f_sym6.CurrentHook = func() int {
+		return ident1
+	}
+}
+
+// CurrentCalled returns true if FakeDocumenter.Current was called
+func (f *FakeDocumenter) CurrentCalled() bool {
+	return len(f.CurrentCalls) != 0
+}
+
+// AssertCurrentCalled calls t.Error if FakeDocumenter.Current was not called
+func (f *FakeDocumenter) AssertCurrentCalled(t DocumenterTestingT) {
+	t.Helper()
+	if len(f.CurrentCalls) == 0 {
+		t.Error("FakeDocumenter.Current not called, expected at least one")
+	}
+}
+
+// CurrentNotCalled returns true if FakeDocumenter.Current was not called
+func (f *FakeDocumenter) CurrentNotCalled() bool {
+	return len(f.CurrentCalls) == 0
+}
+
+// AssertCurrentNotCalled calls t.Error if FakeDocumenter.Current was called
+func (f *FakeDocumenter) AssertCurrentNotCalled(t DocumenterTestingT) {
+	t.Helper()
+	if len(f.CurrentCalls) != 0 {
+		t.Error("FakeDocumenter.Current called, expected none")
+	}
+}
+
+// CurrentCalledOnce returns true if FakeDocumenter.Current was called exactly once
+func (f *FakeDocumenter) CurrentCalledOnce() bool {
+	return len(f.CurrentCalls) == 1
+}
+
+// AssertCurrentCalledOnce calls t.Error if FakeDocumenter.Current was not called exactly once
+func (f *FakeDocumenter) AssertCurrentCalledOnce(t DocumenterTestingT) {
+	t.Helper()
+	if len(f.CurrentCalls) != 1 {
+		t.Errorf("FakeDocumenter.Current called %d times, expected 1", len(f.CurrentCalls))
+	}
+}
+
+// CurrentCalledN returns true if FakeDocumenter.Current was called at least n times
+func (f *FakeDocumenter) CurrentCalledN(n int) bool {
+	return len(f.CurrentCalls) >= n
+}
+
+// AssertCurrentCalledN calls t.Error if FakeDocumenter.Current was called less than n times
+func (f *FakeDocumenter) AssertCurrentCalledN(t DocumenterTestingT, n int) {
+	t.Helper()
+	if len(f.CurrentCalls) < n {
+		t.Errorf("FakeDocumenter.Current called %d times, expected >= %d", len(f.CurrentCalls), n)
+	}
+}
+
+// Update replaces the value.
+//
+// Deprecated: use Replace, Update ignores the context.
+func (f_sym7 *FakeDocumenter) Update(value int) (err error) {
+	if f_sym7.UpdateHook == nil {
+		panic("Documenter.Update() called but FakeDocumenter.UpdateHook is nil")
+	}
+
+	invocation_sym7 := new(DocumenterUpdateInvocation)
+	f_sym7.UpdateCalls = append(f_sym7.UpdateCalls, invocation_sym7)
+
+	invocation_sym7.Parameters.Value = value
+
+	err = f_sym7.UpdateHook(value)
+
+	invocation_sym7.Results.Err = err
+
+	return
+}
+
+// SetUpdateStub configures Documenter.Update to always return the given values
+//
+// Deprecated: use Replace, Update ignores the context.
+func (f_sym8 *FakeDocumenter) SetUpdateStub(err error) {
+	f_sym8.UpdateHook = func(int) error {
+		return err
+	}
+}
+
+// SetUpdateError configures Documenter.Update to always return err and zero values.  Unlike a stub, a strict
+// fake does not require the method to be called.
+//
+// Deprecated: use Replace, Update ignores the context.
+func (f_sym9 *FakeDocumenter) SetUpdateError(err_sym9 error) {
+	f_sym9.UpdateHook = func(int) error {
+		return err_sym9
+	}
+}
+
+// SetUpdateInvocation configures Documenter.Update to return the given results when called with the given parameters
+// If no match is found for an invocation the result(s) of the fallback function are returned
+//
+// Deprecated: use Replace, Update ignores the context.
+func (f_sym10 *FakeDocumenter) SetUpdateInvocation(calls_sym10 []*DocumenterUpdateInvocation, fallback_sym10 func() error) {
+	f_sym10.UpdateHook = func(value int) (err error) {
+		for _, call_sym10 := range calls_sym10 {
+			if reflect.DeepEqual(call_sym10.Parameters.Value, value) {
+				err = call_sym10.Results.Err
+
+				return
+			}
+		}
+
+		return fallback_sym10()
+	}
+}
+
+// UpdateCalled returns true if FakeDocumenter.Update was called
+func (f *FakeDocumenter) UpdateCalled() bool {
+	return len(f.UpdateCalls) != 0
+}
+
+// AssertUpdateCalled calls t.Error if FakeDocumenter.Update was not called
+func (f *FakeDocumenter) AssertUpdateCalled(t DocumenterTestingT) {
+	t.Helper()
+	if len(f.UpdateCalls) == 0 {
+		t.Error("FakeDocumenter.Update not called, expected at least one")
+	}
+}
+
+// UpdateNotCalled returns true if FakeDocumenter.Update was not called
+func (f *FakeDocumenter) UpdateNotCalled() bool {
+	return len(f.UpdateCalls) == 0
+}
+
+// AssertUpdateNotCalled calls t.Error if FakeDocumenter.Update was called
+func (f *FakeDocumenter) AssertUpdateNotCalled(t DocumenterTestingT) {
+	t.Helper()
+	if len(f.UpdateCalls) != 0 {
+		t.Error("FakeDocumenter.Update called, expected none")
+	}
+}
+
+// UpdateCalledOnce returns true if FakeDocumenter.Update was called exactly once
+func (f *FakeDocumenter) UpdateCalledOnce() bool {
+	return len(f.UpdateCalls) == 1
+}
+
+// AssertUpdateCalledOnce calls t.Error if FakeDocumenter.Update was not called exactly once
+func (f *FakeDocumenter) AssertUpdateCalledOnce(t DocumenterTestingT) {
+	t.Helper()
+	if len(f.UpdateCalls) != 1 {
+		t.Errorf("FakeDocumenter.Update called %d times, expected 1", len(f.UpdateCalls))
+	}
+}
+
+// UpdateCalledN returns true if FakeDocumenter.Update was called at least n times
+func (f *FakeDocumenter) UpdateCalledN(n int) bool {
+	return len(f.UpdateCalls) >= n
+}
+
+// AssertUpdateCalledN calls t.Error if FakeDocumenter.Update was called less than n times
+func (f *FakeDocumenter) AssertUpdateCalledN(t DocumenterTestingT, n int) {
+	t.Helper()
+	if len(f.UpdateCalls) < n {
+		t.Errorf("FakeDocumenter.Update called %d times, expected >= %d", len(f.UpdateCalls), n)
+	}
+}
+
+// UpdateCalledWith returns true if FakeDocumenter.Update was called with the given values
+func (f_sym11 *FakeDocumenter) UpdateCalledWith(value int) bool {
+	for _, call_sym11 := range f_sym11.UpdateCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.Value, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertUpdateCalledWith calls t.Error if FakeDocumenter.Update was not called with the given values
+func (f_sym12 *FakeDocumenter) AssertUpdateCalledWith(t DocumenterTestingT, value int) {
+	t.Helper()
+	var found_sym12 bool
+	for _, call_sym12 := range f_sym12.UpdateCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.Value, value) {
+			found_sym12 = true
+			break
+		}
+	}
+
+	if !found_sym12 {
+		t.Error("FakeDocumenter.Update not called with expected parameters")
+	}
+}
+
+// UpdateCalledOnceWith returns true if FakeDocumenter.Update was called exactly once with the given values
+func (f_sym13 *FakeDocumenter) UpdateCalledOnceWith(value int) bool {
+	var count_sym13 int
+	for _, call_sym13 := range f_sym13.UpdateCalls {
+		if reflect.DeepEqual(call_sym13.Parameters.Value, value) {
+			count_sym13++
+		}
+	}
+
+	return count_sym13 == 1
+}
+
+// AssertUpdateCalledOnceWith calls t.Error if FakeDocumenter.Update was not called exactly once with the given values
+func (f_sym14 *FakeDocumenter) AssertUpdateCalledOnceWith(t DocumenterTestingT, value int) {
+	t.Helper()
+	var count_sym14 int
+	for _, call_sym14 := range f_sym14.UpdateCalls {
+		if reflect.DeepEqual(call_sym14.Parameters.Value, value) {
+			count_sym14++
+		}
+	}
+
+	if count_sym14 != 1 {
+		t.Errorf("FakeDocumenter.Update called %d times with expected parameters, expected one", count_sym14)
+	}
+}
+
+// UpdateResultsForCall returns the result values for the first call to FakeDocumenter.Update with the given values
+func (f_sym15 *FakeDocumenter) UpdateResultsForCall(value int) (err error, found_sym15 bool) {
+	for _, call_sym15 := range f_sym15.UpdateCalls {
+		if reflect.DeepEqual(call_sym15.Parameters.Value, value) {
+			err = call_sym15.Results.Err
+			found_sym15 = true
+			break
+		}
+	}
+
+	return
+}
+
+func (f_sym16 *FakeDocumenter) Replace(value int) (err error) {
+	if f_sym16.ReplaceHook == nil {
+		panic("Documenter.Replace() called but FakeDocumenter.ReplaceHook is nil")
+	}
+
+	invocation_sym16 := new(DocumenterReplaceInvocation)
+	f_sym16.ReplaceCalls = append(f_sym16.ReplaceCalls, invocation_sym16)
+
+	invocation_sym16.Parameters.Value = value
+
+	err = f_sym16.ReplaceHook(value)
+
+	invocation_sym16.Results.Err = err
+
+	return
+}
+
+// SetReplaceStub configures Documenter.Replace to always return the given values
+func (f_sym17 *FakeDocumenter) SetReplaceStub(err error) {
+	f_sym17.ReplaceHook = func(int) error {
+		return err
+	}
+}
+
+// SetReplaceError configures Documenter.Replace to always return err and zero values.  Unlike a stub, a strict
+// fake does not require the method to be called.
+func (f_sym18 *FakeDocumenter) SetReplaceError(err_sym18 error) {
+	f_sym18.ReplaceHook = func(int) error {
+		return err_sym18
+	}
+}
+
+// SetReplaceInvocation configures Documenter.Replace to return the given results when called with the given parameters
+// If no match is found for an invocation the result(s) of the fallback function are returned
+func (f_sym19 *FakeDocumenter) SetReplaceInvocation(calls_sym19 []*DocumenterReplaceInvocation, fallback_sym19 func() error) {
+	f_sym19.ReplaceHook = func(value int) (err error) {
+		for _, call_sym19 := range calls_sym19 {
+			if reflect.DeepEqual(call_sym19.Parameters.Value, value) {
+				err = call_sym19.Results.Err
+
+				return
+			}
+		}
+
+		return fallback_sym19()
+	}
+}
+
+// ReplaceCalled returns true if FakeDocumenter.Replace was called
+func (f *FakeDocumenter) ReplaceCalled() bool {
+	return len(f.ReplaceCalls) != 0
+}
+
+// AssertReplaceCalled calls t.Error if FakeDocumenter.Replace was not called
+func (f *FakeDocumenter) AssertReplaceCalled(t DocumenterTestingT) {
+	t.Helper()
+	if len(f.ReplaceCalls) == 0 {
+		t.Error("FakeDocumenter.Replace not called, expected at least one")
+	}
+}
+
+// ReplaceNotCalled returns true if FakeDocumenter.Replace was not called
+func (f *FakeDocumenter) ReplaceNotCalled() bool {
+	return len(f.ReplaceCalls) == 0
+}
+
+// AssertReplaceNotCalled calls t.Error if FakeDocumenter.Replace was called
+func (f *FakeDocumenter) AssertReplaceNotCalled(t DocumenterTestingT) {
+	t.Helper()
+	if len(f.ReplaceCalls) != 0 {
+		t.Error("FakeDocumenter.Replace called, expected none")
+	}
+}
+
+// ReplaceCalledOnce returns true if FakeDocumenter.Replace was called exactly once
+func (f *FakeDocumenter) ReplaceCalledOnce() bool {
+	return len(f.ReplaceCalls) == 1
+}
+
+// AssertReplaceCalledOnce calls t.Error if FakeDocumenter.Replace was not called exactly once
+func (f *FakeDocumenter) AssertReplaceCalledOnce(t DocumenterTestingT) {
+	t.Helper()
+	if len(f.ReplaceCalls) != 1 {
+		t.Errorf("FakeDocumenter.Replace called %d times, expected 1", len(f.ReplaceCalls))
+	}
+}
+
+// ReplaceCalledN returns true if FakeDocumenter.Replace was called at least n times
+func (f *FakeDocumenter) ReplaceCalledN(n int) bool {
+	return len(f.ReplaceCalls) >= n
+}
+
+// AssertReplaceCalledN calls t.Error if FakeDocumenter.Replace was called less than n times
+func (f *FakeDocumenter) AssertReplaceCalledN(t DocumenterTestingT, n int) {
+	t.Helper()
+	if len(f.ReplaceCalls) < n {
+		t.Errorf("FakeDocumenter.Replace called %d times, expected >= %d", len(f.ReplaceCalls), n)
+	}
+}
+
+// ReplaceCalledWith returns true if FakeDocumenter.Replace was called with the given values
+func (f_sym20 *FakeDocumenter) ReplaceCalledWith(value int) bool {
+	for _, call_sym20 := range f_sym20.ReplaceCalls {
+		if reflect.DeepEqual(call_sym20.Parameters.Value, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertReplaceCalledWith calls t.Error if FakeDocumenter.Replace was not called with the given values
+func (f_sym21 *FakeDocumenter) AssertReplaceCalledWith(t DocumenterTestingT, value int) {
+	t.Helper()
+	var found_sym21 bool
+	for _, call_sym21 := range f_sym21.ReplaceCalls {
+		if reflect.DeepEqual(call_sym21.Parameters.Value, value) {
+			found_sym21 = true
+			break
+		}
+	}
+
+	if !found_sym21 {
+		t.Error("FakeDocumenter.Replace not called with expected parameters")
+	}
+}
+
+// ReplaceCalledOnceWith returns true if FakeDocumenter.Replace was called exactly once with the given values
+func (f_sym22 *FakeDocumenter) ReplaceCalledOnceWith(value int) bool {
+	var count_sym22 int
+	for _, call_sym22 := range f_sym22.ReplaceCalls {
+		if reflect.DeepEqual(call_sym22.Parameters.Value, value) {
+			count_sym22++
+		}
+	}
+
+	return count_sym22 == 1
+}
+
+// AssertReplaceCalledOnceWith calls t.Error if FakeDocumenter.Replace was not called exactly once with the given values
+func (f_sym23 *FakeDocumenter) AssertReplaceCalledOnceWith(t DocumenterTestingT, value int) {
+	t.Helper()
+	var count_sym23 int
+	for _, call_sym23 := range f_sym23.ReplaceCalls {
+		if reflect.DeepEqual(call_sym23.Parameters.Value, value) {
+			count_sym23++
+		}
+	}
+
+	if count_sym23 != 1 {
+		t.Errorf("FakeDocumenter.Replace called %d times with expected parameters, expected one", count_sym23)
+	}
+}
+
+// ReplaceResultsForCall returns the result values for the first call to FakeDocumenter.Replace with the given values
+func (f_sym24 *FakeDocumenter) ReplaceResultsForCall(value int) (err error, found_sym24 bool) {
+	for _, call_sym24 := range f_sym24.ReplaceCalls {
+		if reflect.DeepEqual(call_sym24.Parameters.Value, value) {
+			err = call_sym24.Results.Err
+			found_sym24 = true
+			break
+		}
+	}
+
+	return
+}
@@ -0,0 +1,13 @@
+package main
+
+type Documenter interface {
+	// Current returns the current value.
+	//
+	// The value is never negative.
+	Current() int
+	// Update replaces the value.
+	//
+	// Deprecated: use Replace, Update ignores the context.
+	Update(value int) error
+	Replace(value int) error
+}
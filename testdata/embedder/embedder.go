@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/embedder -output=testdata/embedder/embedder.go Embedder".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/embedder -output=testdata/embedder/embedder.go Embedder".  DO NOT EDIT.
 
 package main
 
@@ -7,7 +7,7 @@ import "reflect"
 // EmbedderStringInvocation represents a single call of FakeEmbedder.String
 type EmbedderStringInvocation struct {
 	Results struct {
-		Ident5 string
+		Ident1 string
 	}
 }
 
@@ -69,7 +69,7 @@ Use it in your tests as in this example:
 
 	func TestWithEmbedder(t *testing.T) {
 		f := &main.FakeEmbedder{
-			StringHook: func() (ident5 string) {
+			StringHook: func() (ident1 string) {
 				// ensure parameters meet expectations, signal errors using t, etc
 				return
 			},
@@ -77,13 +77,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeString ...
+		// assert state of FakeEmbedder ...
 		f.AssertStringCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeString.
+unexpected calls are made to FakeEmbedder.
 */
 type FakeEmbedder struct {
 	StringHook func() string
@@ -95,10 +95,12 @@ type FakeEmbedder struct {
 	OtherCalls  []*EmbedderOtherInvocation
 }
 
+var _ Embedder = (*FakeEmbedder)(nil)
+
 // NewFakeEmbedderDefaultPanic returns an instance of FakeEmbedder with all hooks configured to panic
 func NewFakeEmbedderDefaultPanic() *FakeEmbedder {
 	return &FakeEmbedder{
-		StringHook: func() (ident5 string) {
+		StringHook: func() (ident1 string) {
 			panic("Unexpected call to Embedder.String")
 		},
 		EmbedHook: func(string) (ident2 string) {
@@ -113,7 +115,7 @@ func NewFakeEmbedderDefaultPanic() *FakeEmbedder {
 // NewFakeEmbedderDefaultFatal returns an instance of FakeEmbedder with all hooks configured to call t.Fatal
 func NewFakeEmbedderDefaultFatal(t_sym1 EmbedderTestingT) *FakeEmbedder {
 	return &FakeEmbedder{
-		StringHook: func() (ident5 string) {
+		StringHook: func() (ident1 string) {
 			t_sym1.Fatal("Unexpected call to Embedder.String")
 			return
 		},
@@ -131,7 +133,7 @@ func NewFakeEmbedderDefaultFatal(t_sym1 EmbedderTestingT) *FakeEmbedder {
 // NewFakeEmbedderDefaultError returns an instance of FakeEmbedder with all hooks configured to call t.Error
 func NewFakeEmbedderDefaultError(t_sym2 EmbedderTestingT) *FakeEmbedder {
 	return &FakeEmbedder{
-		StringHook: func() (ident5 string) {
+		StringHook: func() (ident1 string) {
 			t_sym2.Error("Unexpected call to Embedder.String")
 			return
 		},
@@ -146,31 +148,72 @@ func NewFakeEmbedderDefaultError(t_sym2 EmbedderTestingT) *FakeEmbedder {
 	}
 }
 
+// NewFakeEmbedderDefaultZero returns an instance of FakeEmbedder with all hooks configured to return zero values
+func NewFakeEmbedderDefaultZero() *FakeEmbedder {
+	return &FakeEmbedder{
+		StringHook: func() (ident1 string) {
+			return
+		},
+		EmbedHook: func(string) (ident2 string) {
+			return
+		},
+		OtherHook: func(string) (ident2 string) {
+			return
+		},
+	}
+}
+
+// NewFakeEmbedderDefaultErr returns an instance of FakeEmbedder with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeEmbedderDefaultErr(err_sym3 error) *FakeEmbedder {
+	return &FakeEmbedder{
+		StringHook: func() (ident1 string) {
+			return
+		},
+		EmbedHook: func(string) (ident2 string) {
+			return
+		},
+		OtherHook: func(string) (ident2 string) {
+			return
+		},
+	}
+}
+
+// NewFakeEmbedderSpy returns an instance of FakeEmbedder with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeEmbedderSpy(real_sym4 Embedder) *FakeEmbedder {
+	return &FakeEmbedder{
+		StringHook: real_sym4.String,
+		EmbedHook:  real_sym4.Embed,
+		OtherHook:  real_sym4.Other,
+	}
+}
+
 func (f *FakeEmbedder) Reset() {
 	f.StringCalls = []*EmbedderStringInvocation{}
 	f.EmbedCalls = []*EmbedderEmbedInvocation{}
 	f.OtherCalls = []*EmbedderOtherInvocation{}
 }
 
-func (f_sym3 *FakeEmbedder) String() (ident5 string) {
-	if f_sym3.StringHook == nil {
+func (f_sym5 *FakeEmbedder) String() (ident1 string) {
+	if f_sym5.StringHook == nil {
 		panic("Embedder.String() called but FakeEmbedder.StringHook is nil")
 	}
 
-	invocation_sym3 := new(EmbedderStringInvocation)
-	f_sym3.StringCalls = append(f_sym3.StringCalls, invocation_sym3)
+	invocation_sym5 := new(EmbedderStringInvocation)
+	f_sym5.StringCalls = append(f_sym5.StringCalls, invocation_sym5)
 
-	ident5 = f_sym3.StringHook()
+	ident1 = f_sym5.StringHook()
 
-	invocation_sym3.Results.Ident5 = ident5
+	invocation_sym5.Results.Ident1 = ident1
 
 	return
 }
 
 // SetStringStub configures Embedder.String to always return the given values
-func (f_sym4 *FakeEmbedder) SetStringStub(ident5 string) {
-	f_sym4.StringHook = func() string {
-		return ident5
+func (f_sym6 *FakeEmbedder) SetStringStub(ident1 string) {
+	f_sym6.StringHook = func() string {
+		return ident1
 	}
 }
 
@@ -226,43 +269,43 @@ func (f *FakeEmbedder) AssertStringCalledN(t EmbedderTestingT, n int) {
 	}
 }
 
-func (f_sym5 *FakeEmbedder) Embed(ident1 string) (ident2 string) {
-	if f_sym5.EmbedHook == nil {
+func (f_sym7 *FakeEmbedder) Embed(ident1 string) (ident2 string) {
+	if f_sym7.EmbedHook == nil {
 		panic("Embedder.Embed() called but FakeEmbedder.EmbedHook is nil")
 	}
 
-	invocation_sym5 := new(EmbedderEmbedInvocation)
-	f_sym5.EmbedCalls = append(f_sym5.EmbedCalls, invocation_sym5)
+	invocation_sym7 := new(EmbedderEmbedInvocation)
+	f_sym7.EmbedCalls = append(f_sym7.EmbedCalls, invocation_sym7)
 
-	invocation_sym5.Parameters.Ident1 = ident1
+	invocation_sym7.Parameters.Ident1 = ident1
 
-	ident2 = f_sym5.EmbedHook(ident1)
+	ident2 = f_sym7.EmbedHook(ident1)
 
-	invocation_sym5.Results.Ident2 = ident2
+	invocation_sym7.Results.Ident2 = ident2
 
 	return
 }
 
 // SetEmbedStub configures Embedder.Embed to always return the given values
-func (f_sym6 *FakeEmbedder) SetEmbedStub(ident2 string) {
-	f_sym6.EmbedHook = func(string) string {
+func (f_sym8 *FakeEmbedder) SetEmbedStub(ident2 string) {
+	f_sym8.EmbedHook = func(string) string {
 		return ident2
 	}
 }
 
 // SetEmbedInvocation configures Embedder.Embed to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym7 *FakeEmbedder) SetEmbedInvocation(calls_sym7 []*EmbedderEmbedInvocation, fallback_sym7 func() string) {
-	f_sym7.EmbedHook = func(ident1 string) (ident2 string) {
-		for _, call_sym7 := range calls_sym7 {
-			if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-				ident2 = call_sym7.Results.Ident2
+func (f_sym9 *FakeEmbedder) SetEmbedInvocation(calls_sym9 []*EmbedderEmbedInvocation, fallback_sym9 func() string) {
+	f_sym9.EmbedHook = func(ident1 string) (ident2 string) {
+		for _, call_sym9 := range calls_sym9 {
+			if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
+				ident2 = call_sym9.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym7()
+		return fallback_sym9()
 	}
 }
 
@@ -319,9 +362,9 @@ func (f *FakeEmbedder) AssertEmbedCalledN(t EmbedderTestingT, n int) {
 }
 
 // EmbedCalledWith returns true if FakeEmbedder.Embed was called with the given values
-func (f_sym8 *FakeEmbedder) EmbedCalledWith(ident1 string) bool {
-	for _, call_sym8 := range f_sym8.EmbedCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
+func (f_sym10 *FakeEmbedder) EmbedCalledWith(ident1 string) bool {
+	for _, call_sym10 := range f_sym10.EmbedCalls {
+		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -330,54 +373,54 @@ func (f_sym8 *FakeEmbedder) EmbedCalledWith(ident1 string) bool {
 }
 
 // AssertEmbedCalledWith calls t.Error if FakeEmbedder.Embed was not called with the given values
-func (f_sym9 *FakeEmbedder) AssertEmbedCalledWith(t EmbedderTestingT, ident1 string) {
+func (f_sym11 *FakeEmbedder) AssertEmbedCalledWith(t EmbedderTestingT, ident1 string) {
 	t.Helper()
-	var found_sym9 bool
-	for _, call_sym9 := range f_sym9.EmbedCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			found_sym9 = true
+	var found_sym11 bool
+	for _, call_sym11 := range f_sym11.EmbedCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.Ident1, ident1) {
+			found_sym11 = true
 			break
 		}
 	}
 
-	if !found_sym9 {
+	if !found_sym11 {
 		t.Error("FakeEmbedder.Embed not called with expected parameters")
 	}
 }
 
 // EmbedCalledOnceWith returns true if FakeEmbedder.Embed was called exactly once with the given values
-func (f_sym10 *FakeEmbedder) EmbedCalledOnceWith(ident1 string) bool {
-	var count_sym10 int
-	for _, call_sym10 := range f_sym10.EmbedCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			count_sym10++
+func (f_sym12 *FakeEmbedder) EmbedCalledOnceWith(ident1 string) bool {
+	var count_sym12 int
+	for _, call_sym12 := range f_sym12.EmbedCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.Ident1, ident1) {
+			count_sym12++
 		}
 	}
 
-	return count_sym10 == 1
+	return count_sym12 == 1
 }
 
 // AssertEmbedCalledOnceWith calls t.Error if FakeEmbedder.Embed was not called exactly once with the given values
-func (f_sym11 *FakeEmbedder) AssertEmbedCalledOnceWith(t EmbedderTestingT, ident1 string) {
+func (f_sym13 *FakeEmbedder) AssertEmbedCalledOnceWith(t EmbedderTestingT, ident1 string) {
 	t.Helper()
-	var count_sym11 int
-	for _, call_sym11 := range f_sym11.EmbedCalls {
-		if reflect.DeepEqual(call_sym11.Parameters.Ident1, ident1) {
-			count_sym11++
+	var count_sym13 int
+	for _, call_sym13 := range f_sym13.EmbedCalls {
+		if reflect.DeepEqual(call_sym13.Parameters.Ident1, ident1) {
+			count_sym13++
 		}
 	}
 
-	if count_sym11 != 1 {
-		t.Errorf("FakeEmbedder.Embed called %d times with expected parameters, expected one", count_sym11)
+	if count_sym13 != 1 {
+		t.Errorf("FakeEmbedder.Embed called %d times with expected parameters, expected one", count_sym13)
 	}
 }
 
 // EmbedResultsForCall returns the result values for the first call to FakeEmbedder.Embed with the given values
-func (f_sym12 *FakeEmbedder) EmbedResultsForCall(ident1 string) (ident2 string, found_sym12 bool) {
-	for _, call_sym12 := range f_sym12.EmbedCalls {
-		if reflect.DeepEqual(call_sym12.Parameters.Ident1, ident1) {
-			ident2 = call_sym12.Results.Ident2
-			found_sym12 = true
+func (f_sym14 *FakeEmbedder) EmbedResultsForCall(ident1 string) (ident2 string, found_sym14 bool) {
+	for _, call_sym14 := range f_sym14.EmbedCalls {
+		if reflect.DeepEqual(call_sym14.Parameters.Ident1, ident1) {
+			ident2 = call_sym14.Results.Ident2
+			found_sym14 = true
 			break
 		}
 	}
@@ -385,43 +428,43 @@ func (f_sym12 *FakeEmbedder) EmbedResultsForCall(ident1 string) (ident2 string,
 	return
 }
 
-func (f_sym13 *FakeEmbedder) Other(ident1 string) (ident2 string) {
-	if f_sym13.OtherHook == nil {
+func (f_sym15 *FakeEmbedder) Other(ident1 string) (ident2 string) {
+	if f_sym15.OtherHook == nil {
 		panic("Embedder.Other() called but FakeEmbedder.OtherHook is nil")
 	}
 
-	invocation_sym13 := new(EmbedderOtherInvocation)
-	f_sym13.OtherCalls = append(f_sym13.OtherCalls, invocation_sym13)
+	invocation_sym15 := new(EmbedderOtherInvocation)
+	f_sym15.OtherCalls = append(f_sym15.OtherCalls, invocation_sym15)
 
-	invocation_sym13.Parameters.Ident1 = ident1
+	invocation_sym15.Parameters.Ident1 = ident1
 
-	ident2 = f_sym13.OtherHook(ident1)
+	ident2 = f_sym15.OtherHook(ident1)
 
-	invocation_sym13.Results.Ident2 = ident2
+	invocation_sym15.Results.Ident2 = ident2
 
 	return
 }
 
 // SetOtherStub configures Embedder.Other to always return the given values
-func (f_sym14 *FakeEmbedder) SetOtherStub(ident2 string) {
-	f_sym14.OtherHook = func(string) string {
+func (f_sym16 *FakeEmbedder) SetOtherStub(ident2 string) {
+	f_sym16.OtherHook = func(string) string {
 		return ident2
 	}
 }
 
 // SetOtherInvocation configures Embedder.Other to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym15 *FakeEmbedder) SetOtherInvocation(calls_sym15 []*EmbedderOtherInvocation, fallback_sym15 func() string) {
-	f_sym15.OtherHook = func(ident1 string) (ident2 string) {
-		for _, call_sym15 := range calls_sym15 {
-			if reflect.DeepEqual(call_sym15.Parameters.Ident1, ident1) {
-				ident2 = call_sym15.Results.Ident2
+func (f_sym17 *FakeEmbedder) SetOtherInvocation(calls_sym17 []*EmbedderOtherInvocation, fallback_sym17 func() string) {
+	f_sym17.OtherHook = func(ident1 string) (ident2 string) {
+		for _, call_sym17 := range calls_sym17 {
+			if reflect.DeepEqual(call_sym17.Parameters.Ident1, ident1) {
+				ident2 = call_sym17.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym15()
+		return fallback_sym17()
 	}
 }
 
@@ -478,9 +521,9 @@ func (f *FakeEmbedder) AssertOtherCalledN(t EmbedderTestingT, n int) {
 }
 
 // OtherCalledWith returns true if FakeEmbedder.Other was called with the given values
-func (f_sym16 *FakeEmbedder) OtherCalledWith(ident1 string) bool {
-	for _, call_sym16 := range f_sym16.OtherCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.Ident1, ident1) {
+func (f_sym18 *FakeEmbedder) OtherCalledWith(ident1 string) bool {
+	for _, call_sym18 := range f_sym18.OtherCalls {
+		if reflect.DeepEqual(call_sym18.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -489,54 +532,54 @@ func (f_sym16 *FakeEmbedder) OtherCalledWith(ident1 string) bool {
 }
 
 // AssertOtherCalledWith calls t.Error if FakeEmbedder.Other was not called with the given values
-func (f_sym17 *FakeEmbedder) AssertOtherCalledWith(t EmbedderTestingT, ident1 string) {
+func (f_sym19 *FakeEmbedder) AssertOtherCalledWith(t EmbedderTestingT, ident1 string) {
 	t.Helper()
-	var found_sym17 bool
-	for _, call_sym17 := range f_sym17.OtherCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.Ident1, ident1) {
-			found_sym17 = true
+	var found_sym19 bool
+	for _, call_sym19 := range f_sym19.OtherCalls {
+		if reflect.DeepEqual(call_sym19.Parameters.Ident1, ident1) {
+			found_sym19 = true
 			break
 		}
 	}
 
-	if !found_sym17 {
+	if !found_sym19 {
 		t.Error("FakeEmbedder.Other not called with expected parameters")
 	}
 }
 
 // OtherCalledOnceWith returns true if FakeEmbedder.Other was called exactly once with the given values
-func (f_sym18 *FakeEmbedder) OtherCalledOnceWith(ident1 string) bool {
-	var count_sym18 int
-	for _, call_sym18 := range f_sym18.OtherCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.Ident1, ident1) {
-			count_sym18++
+func (f_sym20 *FakeEmbedder) OtherCalledOnceWith(ident1 string) bool {
+	var count_sym20 int
+	for _, call_sym20 := range f_sym20.OtherCalls {
+		if reflect.DeepEqual(call_sym20.Parameters.Ident1, ident1) {
+			count_sym20++
 		}
 	}
 
-	return count_sym18 == 1
+	return count_sym20 == 1
 }
 
 // AssertOtherCalledOnceWith calls t.Error if FakeEmbedder.Other was not called exactly once with the given values
-func (f_sym19 *FakeEmbedder) AssertOtherCalledOnceWith(t EmbedderTestingT, ident1 string) {
+func (f_sym21 *FakeEmbedder) AssertOtherCalledOnceWith(t EmbedderTestingT, ident1 string) {
 	t.Helper()
-	var count_sym19 int
-	for _, call_sym19 := range f_sym19.OtherCalls {
-		if reflect.DeepEqual(call_sym19.Parameters.Ident1, ident1) {
-			count_sym19++
+	var count_sym21 int
+	for _, call_sym21 := range f_sym21.OtherCalls {
+		if reflect.DeepEqual(call_sym21.Parameters.Ident1, ident1) {
+			count_sym21++
 		}
 	}
 
-	if count_sym19 != 1 {
-		t.Errorf("FakeEmbedder.Other called %d times with expected parameters, expected one", count_sym19)
+	if count_sym21 != 1 {
+		t.Errorf("FakeEmbedder.Other called %d times with expected parameters, expected one", count_sym21)
 	}
 }
 
 // OtherResultsForCall returns the result values for the first call to FakeEmbedder.Other with the given values
-func (f_sym20 *FakeEmbedder) OtherResultsForCall(ident1 string) (ident2 string, found_sym20 bool) {
-	for _, call_sym20 := range f_sym20.OtherCalls {
-		if reflect.DeepEqual(call_sym20.Parameters.Ident1, ident1) {
-			ident2 = call_sym20.Results.Ident2
-			found_sym20 = true
+func (f_sym22 *FakeEmbedder) OtherResultsForCall(ident1 string) (ident2 string, found_sym22 bool) {
+	for _, call_sym22 := range f_sym22.OtherCalls {
+		if reflect.DeepEqual(call_sym22.Parameters.Ident1, ident1) {
+			ident2 = call_sym22.Results.Ident2
+			found_sym22 = true
 			break
 		}
 	}
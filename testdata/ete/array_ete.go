@@ -2,8 +2,6 @@ package main
 
 import "fmt"
 
-var _ Array = &FakeArray{}
-
 func main() {
 	arrayParameterHookCalled := false
 	arrayReturnHookCalled := false
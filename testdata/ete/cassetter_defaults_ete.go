@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+func main() {
+	failure := errors.New("unavailable")
+	f := NewFakeCassetterDefaultErr(failure)
+	if values, err := f.Fetch(context.TODO(), "a", nil); values != nil || err != failure {
+		panic(fmt.Sprintf("DefaultErr: Fetch returned %v, %v", values, err))
+	}
+	if err := f.Watch(context.TODO(), nil); err != failure {
+		panic(fmt.Sprintf("DefaultErr: Watch returned %v", err))
+	}
+	f = NewFakeCassetterDefaultZero()
+	if values, err := f.Fetch(context.TODO(), "a", nil); values != nil || err != nil {
+		panic(fmt.Sprintf("DefaultZero: Fetch returned %v, %v", values, err))
+	}
+	if err := f.Watch(context.TODO(), nil); err != nil || len(f.WatchCalls) != 1 {
+		panic(fmt.Sprintf("DefaultZero: Watch returned %v", err))
+	}
+	if value, err := f.Get(context.TODO(), nil, nil); value != "" || err != nil {
+		panic(fmt.Sprintf("DefaultZero: Get returned %v, %v", value, err))
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// errorsT records the errors and cleanup functions of the fakes
+type errorsT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (t *errorsT) Error(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *errorsT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+func (t *errorsT) Fatal(args ...interface{}) { panic(fmt.Sprintln(args...)) }
+func (t *errorsT) Helper()                   {}
+func (t *errorsT) Cleanup(f func())          { t.cleanups = append(t.cleanups, f) }
+func (t *errorsT) cleanup() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func main() {
+	failure := errors.New("unavailable")
+	f := new(FakeCassetter)
+	f.FailAll(failure)
+	if values, err := f.Fetch(context.TODO(), "a", nil); values != nil || err != failure {
+		panic(fmt.Sprintf("FailAll: Fetch returned %v, %v", values, err))
+	}
+	if err := f.Watch(context.TODO(), nil); err != failure {
+		panic(fmt.Sprintf("FailAll: Watch returned %v", err))
+	}
+
+	t := new(errorsT)
+	f = NewFakeCassetterStrict(t)
+	f.FailAll(failure)
+	if _, err := f.Fetch(context.TODO(), "a", nil); err != failure {
+		panic(fmt.Sprintf("Strict FailAll: Fetch returned %v", err))
+	}
+	t.cleanup()
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("Strict FailAll: %q", t.errors))
+	}
+
+	t = new(errorsT)
+	f = NewFakeCassetterStrict(t)
+	f.SetGetStub("body", nil)
+	f.SetGetError(failure)
+	if _, err := f.Get(context.TODO(), nil, nil); err != failure {
+		panic(fmt.Sprintf("Strict SetGetError: Get returned %v", err))
+	}
+	f.Get(context.TODO(), nil, nil)
+	t.cleanup()
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("Strict SetGetError: %q", t.errors))
+	}
+
+	f = new(FakeCassetter)
+	f.SetFetchStub([]string{"a"}, nil)
+	f.SetFetchErrorOnCall(2, failure)
+	for call, expected := range []error{nil, failure, nil} {
+		if _, err := f.Fetch(context.TODO(), "a", nil); err != expected {
+			panic(fmt.Sprintf("SetFetchErrorOnCall: call %d returned %v", call+1, err))
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// store is the real implementation recorded to a cassette
+type store struct{}
+
+func (store) Fetch(ctx context.Context, id string, progress func(int)) ([]string, error) {
+	progress(100)
+	if id == "missing" {
+		return nil, errors.New("not found: " + id)
+	}
+
+	return []string{id, strings.ToUpper(id)}, nil
+}
+
+func (store) Get(ctx context.Context, r io.Reader, cb Callback) (string, error) {
+	data, err := ioutil.ReadAll(r)
+	cb(len(data))
+
+	return string(data), err
+}
+
+func (store) Watch(ctx context.Context, events chan<- string) error {
+	return nil
+}
+
+// cassetteT records the errors and cleanup functions of the fakes
+type cassetteT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (t *cassetteT) Error(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *cassetteT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+func (t *cassetteT) Fatal(args ...interface{}) { panic(fmt.Sprintln(args...)) }
+func (t *cassetteT) Helper()                   {}
+func (t *cassetteT) Cleanup(f func())          { t.cleanups = append(t.cleanups, f) }
+func (t *cassetteT) cleanup() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func main() {
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+
+	progress := 0
+	t := new(cassetteT)
+	f := NewFakeCassetterRecord(t, path, store{})
+	values, err := f.Fetch(context.Background(), "a", func(p int) { progress = p })
+	if fmt.Sprint(values) != "[a A]" || err != nil || progress != 100 {
+		panic(fmt.Sprintf("Record: Fetch returned %v, %v", values, err))
+	}
+	if _, err := f.Fetch(context.Background(), "missing", func(int) {}); err == nil {
+		panic("Record: Fetch did not return an error")
+	}
+	if value, err := f.Get(context.Background(), strings.NewReader("body"), func(int) {}); value != "body" || err != nil {
+		panic(fmt.Sprintf("Record: Get returned %v, %v", value, err))
+	}
+	if err := f.Watch(context.Background(), make(chan string)); err != nil {
+		panic(fmt.Sprintf("Record: Watch returned %v", err))
+	}
+	if len(f.FetchCalls) != 2 || len(f.GetCalls) != 1 || len(f.WatchCalls) != 1 {
+		panic("Record: calls not recorded")
+	}
+	t.cleanup()
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("Record: %q", t.errors))
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	if !strings.Contains(string(data), `"err": "not found: missing"`) || strings.Contains(string(data), "ctx") {
+		panic(fmt.Sprintf("Record: unexpected cassette %s", data))
+	}
+
+	t = new(cassetteT)
+	f = NewFakeCassetterReplay(t, path)
+	if value, err := f.Get(context.TODO(), nil, nil); value != "body" || err != nil {
+		panic(fmt.Sprintf("Replay: Get returned %v, %v", value, err))
+	}
+	if err := f.Watch(context.TODO(), nil); err != nil {
+		panic(fmt.Sprintf("Replay: Watch returned %v", err))
+	}
+	if _, err := f.Fetch(context.TODO(), "missing", nil); err == nil || err.Error() != "not found: missing" {
+		panic(fmt.Sprintf("Replay: Fetch returned error %v", err))
+	}
+	values, err = f.Fetch(context.TODO(), "a", nil)
+	if fmt.Sprint(values) != "[a A]" || err != nil {
+		panic(fmt.Sprintf("Replay: Fetch returned %v, %v", values, err))
+	}
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("Replay: %q", t.errors))
+	}
+
+	f.Fetch(context.TODO(), "a", nil)
+	f.Fetch(context.TODO(), "b", nil)
+	expected := []string{
+		`Cassetter.Fetch() called with {"id":"a"} but no such call is left in cassette ` + path,
+		`Cassetter.Fetch() called with {"id":"b"} but no such call is left in cassette ` + path,
+	}
+	if fmt.Sprint(t.errors) != fmt.Sprint(expected) {
+		panic(fmt.Sprintf("Replay: %q", t.errors))
+	}
+}
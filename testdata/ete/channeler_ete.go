@@ -4,8 +4,6 @@ import (
 	"fmt"
 )
 
-var _ Channeler = &FakeChanneler{}
-
 func testChannel() {
 	hookCalled := false
 	expected := 9
@@ -4,8 +4,6 @@ import (
 	"fmt"
 )
 
-var _ Embedder = &FakeEmbedder{}
-
 func main() {
 	embedHookCalled := false
 	otherHookCalled := false
@@ -0,0 +1,18 @@
+package main
+
+// embedder is the real implementation observed by a spy
+type embedder struct{}
+
+func (embedder) Embed(v string) string { return "embed " + v }
+func (embedder) Other(v string) string { return "other " + v }
+func (embedder) String() string        { return "embedder" }
+
+func main() {
+	spy := NewFakeEmbedderSpy(embedder{})
+	if spy.Embed("a") != "embed a" || spy.Other("b") != "other b" || spy.String() != "embedder" {
+		panic("NewFakeEmbedderSpy: the methods of the embedded interfaces not forwarded")
+	}
+	if !spy.EmbedCalledOnceWith("a") || !spy.StringCalledOnce() {
+		panic("NewFakeEmbedderSpy: calls not recorded")
+	}
+}
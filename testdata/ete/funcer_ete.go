@@ -2,8 +2,6 @@ package main
 
 import "fmt"
 
-var _ Funcer = &FakeFuncer{}
-
 func oracle(s string) string {
 	if s == "answer" {
 		return "42"
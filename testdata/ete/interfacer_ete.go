@@ -4,8 +4,6 @@ import (
 	"fmt"
 )
 
-var _ Interfacer = &FakeInterfacer{}
-
 func main() {
 	interfaceHookCalled := false
 	namedInterfaceHookCalled := false
@@ -2,8 +2,6 @@ package main
 
 import "fmt"
 
-var _ Mapper = &FakeMapper{}
-
 func main() {
 	mapParameterHookCalled := false
 	mapReturnHookCalled := false
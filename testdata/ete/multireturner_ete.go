@@ -4,8 +4,6 @@ import (
 	"fmt"
 )
 
-var _ Multireturner = &FakeMultireturner{}
-
 func main() {
 	multiReturnHookCalled := false
 	namedHookCalled := false
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+)
+
+// matchersT records the errors reported by the fake
+type matchersT struct {
+	errors []string
+}
+
+func (t *matchersT) Error(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *matchersT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+func (t *matchersT) Fatal(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *matchersT) Helper()                   {}
+
+func main() {
+	f := new(FakeNamedvaluer)
+	f.SetNamedStub(true)
+	f.Named(9, "one")
+	f.Named(3, "one")
+
+	if !f.NamedCalledWithMatch(CharlatanFunc(func(v interface{}) bool { return v.(int) > 8 }), CharlatanEq("one")) {
+		panic("NamedCalledWithMatch: Named not called with > 8")
+	}
+	if f.NamedCalledWithMatch(CharlatanAny(), CharlatanNot(CharlatanEq("one"))) {
+		panic("NamedCalledWithMatch: Named called with other than one")
+	}
+
+	t := new(matchersT)
+	f.AssertNamedCalledWithMatch(t, CharlatanEq(3), CharlatanAny())
+	f.AssertNamedCalledWithMatch(t, CharlatanEq(4), CharlatanAny())
+	if len(t.errors) != 1 {
+		panic(fmt.Sprintf("AssertNamedCalledWithMatch: %q", t.errors))
+	}
+
+	f.SetManyNamedStub(false)
+	f.SetManyNamedInvocationMatch(CharlatanEq("one"), CharlatanAny(), CharlatanAny(), CharlatanNot(CharlatanEq(4)), true)
+	if !f.ManyNamed("one", "two", 3, 3) {
+		panic("SetManyNamedInvocationMatch: matching parameters did not return true")
+	}
+	if f.ManyNamed("one", "two", 3, 4) || f.ManyNamed("two", "two", 3, 3) {
+		panic("SetManyNamedInvocationMatch: unmatched parameters did not return false")
+	}
+
+	type request struct {
+		UserID int
+		Name   string
+	}
+	if !CharlatanFieldsEq("UserID", 42, "Name", CharlatanNot(CharlatanEq(""))).Match(&request{UserID: 42, Name: "user"}) {
+		panic("CharlatanFieldsEq: request not matched")
+	}
+	if CharlatanFieldsEq("UserID", 42).Match(request{UserID: 41}) || CharlatanFieldsEq("Missing", 42).Match(request{}) || CharlatanFieldsEq("UserID", 42).Match(42) {
+		panic("CharlatanFieldsEq: unexpected match")
+	}
+}
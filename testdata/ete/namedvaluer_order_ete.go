@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+)
+
+// orderT records the errors reported by the fakes
+type orderT struct {
+	errors []string
+}
+
+func (t *orderT) Error(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *orderT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+func (t *orderT) Fatal(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *orderT) Helper()                   {}
+
+func main() {
+	f := new(FakeNamedvaluer)
+	f.NamedHook = func(int, string) bool { return true }
+	f.ManyNamedHook = func(string, string, int, int) bool { return true }
+	f.Named(3, "one")
+	f.ManyNamed("one", "two", 3, 3)
+	f.Named(4, "two")
+
+	t := new(orderT)
+	f.AssertCallOrder(t, "Named", "ManyNamed", "Named")
+	CharlatanInOrder(t, f.NamedCalls[0], f.ManyNamedCalls[0], f.NamedCalls[1])
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("AssertCallOrder: %v", t.errors))
+	}
+
+	f.AssertCallOrder(t, "ManyNamed", "Named", "ManyNamed")
+	CharlatanInOrder(t, f.ManyNamedCalls[0], f.NamedCalls[0])
+	expected := []string{
+		`FakeNamedvaluer methods not called in the order ["ManyNamed" "Named" "ManyNamed"], actual order: FakeNamedvaluer.Named, FakeNamedvaluer.ManyNamed, FakeNamedvaluer.Named`,
+		`calls not made in the order FakeNamedvaluer.ManyNamed, FakeNamedvaluer.Named, actual order: FakeNamedvaluer.Named, FakeNamedvaluer.ManyNamed`,
+	}
+	if fmt.Sprint(t.errors) != fmt.Sprint(expected) {
+		panic(fmt.Sprintf("AssertCallOrder: %q", t.errors))
+	}
+
+	g := new(FakeNamedvaluer)
+	g.NamedHook = f.NamedHook
+	g.Named(5, "three")
+	f.Reset()
+	f.Named(6, "four")
+
+	t = new(orderT)
+	CharlatanInOrder(t, g.NamedCalls[0], f.NamedCalls[0])
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("CharlatanInOrder: calls of two fakes %v", t.errors))
+	}
+	CharlatanInOrder(t, f.NamedCalls[0], g.NamedCalls[0])
+	if len(t.errors) != 1 {
+		panic(fmt.Sprintf("CharlatanInOrder: calls of two fakes %q", t.errors))
+	}
+}
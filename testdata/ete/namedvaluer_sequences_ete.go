@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+)
+
+// sequencesT records the errors reported by the fake
+type sequencesT struct {
+	errors []string
+}
+
+func (t *sequencesT) Error(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *sequencesT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+func (t *sequencesT) Fatal(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *sequencesT) Helper()                   {}
+
+func main() {
+	f := new(FakeNamedvaluer)
+	f.SetNamedStubSequence(NamedvaluerNamedResults{Ret: false}, NamedvaluerNamedResults{Ret: true})
+	if f.Named(3, "one") || !f.Named(3, "one") || !f.Named(3, "one") {
+		panic("SetNamedStubSequence: the last result is not repeated")
+	}
+
+	t := new(sequencesT)
+	f.SetNamedStubSequenceExhausted(CharlatanFailWhenExhausted(t), NamedvaluerNamedResults{Ret: true})
+	if !f.Named(3, "one") || !f.Named(3, "one") {
+		panic("SetNamedStubSequenceExhausted: unexpected results")
+	}
+	if fmt.Sprint(t.errors) != "[Namedvaluer.Named() called more than 1 times, the length of its stub sequence]" {
+		panic(fmt.Sprintf("CharlatanFailWhenExhausted: %q", t.errors))
+	}
+
+	f.SetNamedStubSequenceExhausted(CharlatanPanicWhenExhausted())
+	func() {
+		defer func() {
+			if recover() == nil {
+				panic("CharlatanPanicWhenExhausted: no panic")
+			}
+		}()
+		f.Named(3, "one")
+	}()
+
+	f = new(FakeNamedvaluer)
+	f.SetNamedStub(true)
+	f.SetNamedStubOnCall(2, false)
+	if !f.Named(3, "one") || f.Named(3, "one") || !f.Named(3, "one") {
+		panic("SetNamedStubOnCall: unexpected results")
+	}
+
+	// N.B. - the calls configured by SetManyNamedStubOnCall are counted without a hook
+	f.SetManyNamedStubOnCall(1, true)
+	if !f.ManyNamed("one", "two", 3, 4) {
+		panic("SetManyNamedStubOnCall: unexpected result")
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				panic("SetManyNamedStubOnCall: no panic for a call without a hook")
+			}
+		}()
+		f.ManyNamed("one", "two", 3, 4)
+	}()
+}
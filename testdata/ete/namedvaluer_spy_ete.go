@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+)
+
+// namedvaluer is the real implementation observed by a spy
+type namedvaluer struct{}
+
+func (namedvaluer) ManyNamed(a, b string, f, g int) bool { return a == b && f == g }
+func (namedvaluer) Named(a int, b string) bool           { return len(b) == 5 && a == 3 }
+
+func main() {
+	spy := NewFakeNamedvaluerSpy(namedvaluer{})
+	if !spy.Named(3, "three") || spy.Named(4, "three") || !spy.ManyNamed("one", "one", 3, 3) {
+		panic("NewFakeNamedvaluerSpy: unexpected results")
+	}
+	if !spy.NamedCalledWith(4, "three") || !spy.ManyNamedCalledOnce() {
+		panic("NewFakeNamedvaluerSpy: calls not recorded")
+	}
+
+	spy.SetNamedStubOnCall(4, false)
+	if !spy.Named(3, "three") || spy.Named(3, "three") || !spy.Named(3, "three") {
+		panic("NewFakeNamedvaluerSpy: the real implementation not called around the overridden call")
+	}
+	if len(spy.NamedCalls) != 5 {
+		panic(fmt.Sprintf("NewFakeNamedvaluerSpy: %d calls of Named", len(spy.NamedCalls)))
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+)
+
+// strictT records the errors and cleanup functions of the fakes
+type strictT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (t *strictT) Cleanup(f func()) { t.cleanups = append(t.cleanups, f) }
+func (t *strictT) cleanup() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func (t *strictT) Error(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *strictT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+func (t *strictT) Fatal(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *strictT) Helper()                   {}
+
+func main() {
+	t := new(strictT)
+	strict := NewFakeNamedvaluerStrict(t)
+	strict.SetNamedStub(true)
+	strict.SetNamedInvocation([]*NamedvaluerNamedInvocation{
+		NewNamedvaluerNamedInvocation(3, "one", false),
+		NewNamedvaluerNamedInvocation(4, "two", false),
+	}, func() bool { return true })
+	strict.SetManyNamedStubSequence(NamedvaluerManyNamedResults{Ret: true}, NamedvaluerManyNamedResults{Ret: false})
+	if strict.Named(3, "one") || !strict.ManyNamed("one", "two", 3, 4) {
+		panic("NewFakeNamedvaluerStrict: unexpected results")
+	}
+	t.cleanup()
+	// N.B. - the stub replaced by SetNamedInvocation is no longer expected to be used
+	expected := []string{
+		`FakeNamedvaluer.SetManyNamedStubSequence configured with 2 results but Namedvaluer.ManyNamed called 1 times`,
+		`FakeNamedvaluer.SetNamedInvocation configured with {A:4 B:two} but Namedvaluer.Named not called with those parameters`,
+	}
+	if fmt.Sprint(t.errors) != fmt.Sprint(expected) {
+		panic(fmt.Sprintf("NewFakeNamedvaluerStrict: %q", t.errors))
+	}
+
+	t = new(strictT)
+	strict = NewFakeNamedvaluerStrict(t)
+	strict.SetNamedStub(true)
+	strict.SetNamedStub(false)
+	strict.SetManyNamedStub(true)
+	strict.SetManyNamedInvocationMatch(CharlatanEq("two"), CharlatanAny(), CharlatanAny(), CharlatanAny(), false)
+	if strict.Named(3, "one") || !strict.ManyNamed("one", "two", 3, 4) {
+		panic("NewFakeNamedvaluerStrict: unexpected results of the replaced stubs")
+	}
+	t.cleanup()
+	if fmt.Sprint(t.errors) != "[FakeNamedvaluer.SetManyNamedInvocationMatch configured but Namedvaluer.ManyNamed not called with matching parameters]" {
+		panic(fmt.Sprintf("NewFakeNamedvaluerStrict: %q", t.errors))
+	}
+
+	t = new(strictT)
+	strict = NewFakeNamedvaluerStrict(t)
+	strict.Named(3, "one")
+	strict.Named(3, "one")
+	t.cleanup()
+	if fmt.Sprint(t.errors) != "[Namedvaluer.Named called 2 times without a configured hook]" {
+		panic(fmt.Sprintf("NewFakeNamedvaluerStrict: %q", t.errors))
+	}
+
+	t = new(strictT)
+	strict = NewFakeNamedvaluerStrict(t)
+	strict.SetNamedStub(true)
+	strict.SetNamedStubOnCall(3, false)
+	strict.Named(3, "one")
+	t.cleanup()
+	if fmt.Sprint(t.errors) != "[FakeNamedvaluer.SetNamedStubOnCall configured for call 3 but Namedvaluer.Named called 1 times]" {
+		panic(fmt.Sprintf("NewFakeNamedvaluerStrict: %q", t.errors))
+	}
+}
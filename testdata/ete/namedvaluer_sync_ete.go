@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+// main shares a fake between goroutines, it is run with the race detector
+func main() {
+	f := new(FakeNamedvaluer)
+	f.SetNamedStub(true)
+	f.SetManyNamedStub(false)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(4)
+		go func(i int) {
+			defer wg.Done()
+			if !f.Named(i, "one") {
+				panic("Named: unexpected result")
+			}
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			f.ManyNamed("one", "two", i, i)
+		}(i)
+		go func() {
+			defer wg.Done()
+			for _, call := range f.NamedCallsSnapshot() {
+				if call.Parameters.B != "one" {
+					panic(fmt.Sprintf("NamedCallsSnapshot: unexpected call %+v", call))
+				}
+			}
+			f.ManyNamedCalledWith("one", "two", 0, 0)
+		}()
+		go func() {
+			defer wg.Done()
+			f.SetManyNamedStub(false)
+		}()
+	}
+	wg.Wait()
+
+	if calls := f.NamedCallsSnapshot(); len(calls) != 10 {
+		panic(fmt.Sprintf("NamedCallsSnapshot: %d calls", len(calls)))
+	}
+	if !f.NamedCalledN(10) || !f.ManyNamedCalledN(10) {
+		panic("CalledN: calls not recorded")
+	}
+	if !f.ManyNamedCalledWith("one", "two", 9, 9) {
+		panic("ManyNamedCalledWith: call not recorded")
+	}
+
+	snapshot := f.NamedCallsSnapshot()
+	f.Reset()
+	if len(snapshot) != 10 || f.NamedCalled() || len(f.NamedCallsSnapshot()) != 0 {
+		panic("Reset: calls not cleared, or the snapshot changed")
+	}
+}
@@ -2,8 +2,6 @@ package main
 
 import "fmt"
 
-var _ Pointer = &FakePointer{}
-
 func main() {
 	pointHookCalled := false
 
@@ -2,8 +2,6 @@ package main
 
 import "fmt"
 
-var _ Qualifier = &FakeQualifier{}
-
 type NiceScanner struct {
 	name string
 }
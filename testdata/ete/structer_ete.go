@@ -2,8 +2,6 @@ package main
 
 import "fmt"
 
-var _ Structer = &FakeStructer{}
-
 func main() {
 	structHookCalled := false
 	namedStructHookCalled := false
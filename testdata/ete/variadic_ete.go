@@ -2,8 +2,6 @@ package main
 
 import "fmt"
 
-var _ Variadic = &FakeVariadic{}
-
 func main() {
 	singleHookCalled := false
 	mixedHookCalled := false
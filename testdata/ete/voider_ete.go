@@ -2,8 +2,6 @@ package main
 
 import "fmt"
 
-var _ Voider = &FakeVoider{}
-
 func main() {
 	hookCalled := false
 	f := &FakeVoider{
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/funcer -output=testdata/funcer/funcer.go Funcer".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/funcer -output=testdata/funcer/funcer.go Funcer".  DO NOT EDIT.
 
 package main
 
@@ -42,13 +42,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeFuncParameter ...
+		// assert state of FakeFuncer ...
 		f.AssertFuncParameterCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeFuncParameter.
+unexpected calls are made to FakeFuncer.
 */
 type FakeFuncer struct {
 	FuncParameterHook func(func(string) string)
@@ -58,6 +58,8 @@ type FakeFuncer struct {
 	FuncReturnCalls    []*FuncerFuncReturnInvocation
 }
 
+var _ Funcer = (*FakeFuncer)(nil)
+
 // NewFakeFuncerDefaultPanic returns an instance of FakeFuncer with all hooks configured to panic
 func NewFakeFuncerDefaultPanic() *FakeFuncer {
 	return &FakeFuncer{
@@ -98,22 +100,56 @@ func NewFakeFuncerDefaultError(t_sym2 FuncerTestingT) *FakeFuncer {
 	}
 }
 
+// NewFakeFuncerDefaultZero returns an instance of FakeFuncer with all hooks configured to return zero values
+func NewFakeFuncerDefaultZero() *FakeFuncer {
+	return &FakeFuncer{
+		FuncParameterHook: func(func(string) string) {
+			return
+		},
+		FuncReturnHook: func() (ident1 func(string) string) {
+			return
+		},
+	}
+}
+
+// NewFakeFuncerDefaultErr returns an instance of FakeFuncer with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeFuncerDefaultErr(err_sym3 error) *FakeFuncer {
+	return &FakeFuncer{
+		FuncParameterHook: func(func(string) string) {
+			return
+		},
+		FuncReturnHook: func() (ident1 func(string) string) {
+			return
+		},
+	}
+}
+
+// NewFakeFuncerSpy returns an instance of FakeFuncer with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeFuncerSpy(real_sym4 Funcer) *FakeFuncer {
+	return &FakeFuncer{
+		FuncParameterHook: real_sym4.FuncParameter,
+		FuncReturnHook:    real_sym4.FuncReturn,
+	}
+}
+
 func (f *FakeFuncer) Reset() {
 	f.FuncParameterCalls = []*FuncerFuncParameterInvocation{}
 	f.FuncReturnCalls = []*FuncerFuncReturnInvocation{}
 }
 
-func (f_sym3 *FakeFuncer) FuncParameter(ident1 func(string) string) {
-	if f_sym3.FuncParameterHook == nil {
+func (f_sym5 *FakeFuncer) FuncParameter(ident1 func(string) string) {
+	if f_sym5.FuncParameterHook == nil {
 		panic("Funcer.FuncParameter() called but FakeFuncer.FuncParameterHook is nil")
 	}
 
-	invocation_sym3 := new(FuncerFuncParameterInvocation)
-	f_sym3.FuncParameterCalls = append(f_sym3.FuncParameterCalls, invocation_sym3)
+	invocation_sym5 := new(FuncerFuncParameterInvocation)
+	f_sym5.FuncParameterCalls = append(f_sym5.FuncParameterCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation_sym5.Parameters.Ident1 = ident1
 
-	f_sym3.FuncParameterHook(ident1)
+	f_sym5.FuncParameterHook(ident1)
 
 	return
 }
@@ -171,9 +207,9 @@ func (f *FakeFuncer) AssertFuncParameterCalledN(t FuncerTestingT, n int) {
 }
 
 // FuncParameterCalledWith returns true if FakeFuncer.FuncParameter was called with the given values
-func (f_sym4 *FakeFuncer) FuncParameterCalledWith(ident1 func(string) string) bool {
-	for _, call_sym4 := range f_sym4.FuncParameterCalls {
-		if reflect.DeepEqual(call_sym4.Parameters.Ident1, ident1) {
+func (f_sym6 *FakeFuncer) FuncParameterCalledWith(ident1 func(string) string) bool {
+	for _, call_sym6 := range f_sym6.FuncParameterCalls {
+		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -182,66 +218,66 @@ func (f_sym4 *FakeFuncer) FuncParameterCalledWith(ident1 func(string) string) bo
 }
 
 // AssertFuncParameterCalledWith calls t.Error if FakeFuncer.FuncParameter was not called with the given values
-func (f_sym5 *FakeFuncer) AssertFuncParameterCalledWith(t FuncerTestingT, ident1 func(string) string) {
+func (f_sym7 *FakeFuncer) AssertFuncParameterCalledWith(t FuncerTestingT, ident1 func(string) string) {
 	t.Helper()
-	var found_sym5 bool
-	for _, call_sym5 := range f_sym5.FuncParameterCalls {
-		if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-			found_sym5 = true
+	var found_sym7 bool
+	for _, call_sym7 := range f_sym7.FuncParameterCalls {
+		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
+			found_sym7 = true
 			break
 		}
 	}
 
-	if !found_sym5 {
+	if !found_sym7 {
 		t.Error("FakeFuncer.FuncParameter not called with expected parameters")
 	}
 }
 
 // FuncParameterCalledOnceWith returns true if FakeFuncer.FuncParameter was called exactly once with the given values
-func (f_sym6 *FakeFuncer) FuncParameterCalledOnceWith(ident1 func(string) string) bool {
-	var count_sym6 int
-	for _, call_sym6 := range f_sym6.FuncParameterCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
-			count_sym6++
+func (f_sym8 *FakeFuncer) FuncParameterCalledOnceWith(ident1 func(string) string) bool {
+	var count_sym8 int
+	for _, call_sym8 := range f_sym8.FuncParameterCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
+			count_sym8++
 		}
 	}
 
-	return count_sym6 == 1
+	return count_sym8 == 1
 }
 
 // AssertFuncParameterCalledOnceWith calls t.Error if FakeFuncer.FuncParameter was not called exactly once with the given values
-func (f_sym7 *FakeFuncer) AssertFuncParameterCalledOnceWith(t FuncerTestingT, ident1 func(string) string) {
+func (f_sym9 *FakeFuncer) AssertFuncParameterCalledOnceWith(t FuncerTestingT, ident1 func(string) string) {
 	t.Helper()
-	var count_sym7 int
-	for _, call_sym7 := range f_sym7.FuncParameterCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			count_sym7++
+	var count_sym9 int
+	for _, call_sym9 := range f_sym9.FuncParameterCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
+			count_sym9++
 		}
 	}
 
-	if count_sym7 != 1 {
-		t.Errorf("FakeFuncer.FuncParameter called %d times with expected parameters, expected one", count_sym7)
+	if count_sym9 != 1 {
+		t.Errorf("FakeFuncer.FuncParameter called %d times with expected parameters, expected one", count_sym9)
 	}
 }
 
-func (f_sym8 *FakeFuncer) FuncReturn() (ident1 func(string) string) {
-	if f_sym8.FuncReturnHook == nil {
+func (f_sym10 *FakeFuncer) FuncReturn() (ident1 func(string) string) {
+	if f_sym10.FuncReturnHook == nil {
 		panic("Funcer.FuncReturn() called but FakeFuncer.FuncReturnHook is nil")
 	}
 
-	invocation_sym8 := new(FuncerFuncReturnInvocation)
-	f_sym8.FuncReturnCalls = append(f_sym8.FuncReturnCalls, invocation_sym8)
+	invocation_sym10 := new(FuncerFuncReturnInvocation)
+	f_sym10.FuncReturnCalls = append(f_sym10.FuncReturnCalls, invocation_sym10)
 
-	ident1 = f_sym8.FuncReturnHook()
+	ident1 = f_sym10.FuncReturnHook()
 
-	invocation_sym8.Results.Ident1 = ident1
+	invocation_sym10.Results.Ident1 = ident1
 
 	return
 }
 
 // SetFuncReturnStub configures Funcer.FuncReturn to always return the given values
-func (f_sym9 *FakeFuncer) SetFuncReturnStub(ident1 func(string) string) {
-	f_sym9.FuncReturnHook = func() func(string) string {
+func (f_sym11 *FakeFuncer) SetFuncReturnStub(ident1 func(string) string) {
+	f_sym11.FuncReturnHook = func() func(string) string {
 		return ident1
 	}
 }
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/identifier -output=testdata/identifier/identifier.go Identifier".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/identifier -output=testdata/identifier/identifier.go Identifier".  DO NOT EDIT.
 
 package main
 
@@ -74,22 +74,26 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeTestConstructor ...
+		// assert state of FakeIdentifier ...
 		f.AssertTestConstructorCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeTestConstructor.
+unexpected calls are made to FakeIdentifier.
 */
 type FakeIdentifier struct {
-	TestConstructorHook  func(int64) string
+	// Issue #20 named return identifier conflicts with test context constructor parameter
+	TestConstructorHook func(int64) string
+	// Issue #21 named return identifer conflicts with Set{Method}Invocation parameter(s)
 	InvocationSetterHook func(int64) (string, string, string)
 
 	TestConstructorCalls  []*IdentifierTestConstructorInvocation
 	InvocationSetterCalls []*IdentifierInvocationSetterInvocation
 }
 
+var _ Identifier = (*FakeIdentifier)(nil)
+
 // NewFakeIdentifierDefaultPanic returns an instance of FakeIdentifier with all hooks configured to panic
 func NewFakeIdentifierDefaultPanic() *FakeIdentifier {
 	return &FakeIdentifier{
@@ -130,48 +134,83 @@ func NewFakeIdentifierDefaultError(t_sym2 IdentifierTestingT) *FakeIdentifier {
 	}
 }
 
+// NewFakeIdentifierDefaultZero returns an instance of FakeIdentifier with all hooks configured to return zero values
+func NewFakeIdentifierDefaultZero() *FakeIdentifier {
+	return &FakeIdentifier{
+		TestConstructorHook: func(int64) (t string) {
+			return
+		},
+		InvocationSetterHook: func(int64) (call string, calls string, fallback string) {
+			return
+		},
+	}
+}
+
+// NewFakeIdentifierDefaultErr returns an instance of FakeIdentifier with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeIdentifierDefaultErr(err_sym3 error) *FakeIdentifier {
+	return &FakeIdentifier{
+		TestConstructorHook: func(int64) (t string) {
+			return
+		},
+		InvocationSetterHook: func(int64) (call string, calls string, fallback string) {
+			return
+		},
+	}
+}
+
+// NewFakeIdentifierSpy returns an instance of FakeIdentifier with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeIdentifierSpy(real_sym4 Identifier) *FakeIdentifier {
+	return &FakeIdentifier{
+		TestConstructorHook:  real_sym4.TestConstructor,
+		InvocationSetterHook: real_sym4.InvocationSetter,
+	}
+}
+
 func (f *FakeIdentifier) Reset() {
 	f.TestConstructorCalls = []*IdentifierTestConstructorInvocation{}
 	f.InvocationSetterCalls = []*IdentifierInvocationSetterInvocation{}
 }
 
-func (f_sym3 *FakeIdentifier) TestConstructor(val int64) (t string) {
-	if f_sym3.TestConstructorHook == nil {
+// Issue #20 named return identifier conflicts with test context constructor parameter
+func (f_sym5 *FakeIdentifier) TestConstructor(val int64) (t string) {
+	if f_sym5.TestConstructorHook == nil {
 		panic("Identifier.TestConstructor() called but FakeIdentifier.TestConstructorHook is nil")
 	}
 
-	invocation_sym3 := new(IdentifierTestConstructorInvocation)
-	f_sym3.TestConstructorCalls = append(f_sym3.TestConstructorCalls, invocation_sym3)
+	invocation_sym5 := new(IdentifierTestConstructorInvocation)
+	f_sym5.TestConstructorCalls = append(f_sym5.TestConstructorCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.Val = val
+	invocation_sym5.Parameters.Val = val
 
-	t = f_sym3.TestConstructorHook(val)
+	t = f_sym5.TestConstructorHook(val)
 
-	invocation_sym3.Results.T = t
+	invocation_sym5.Results.T = t
 
 	return
 }
 
 // SetTestConstructorStub configures Identifier.TestConstructor to always return the given values
-func (f_sym4 *FakeIdentifier) SetTestConstructorStub(t string) {
-	f_sym4.TestConstructorHook = func(int64) string {
+func (f_sym6 *FakeIdentifier) SetTestConstructorStub(t string) {
+	f_sym6.TestConstructorHook = func(int64) string {
 		return t
 	}
 }
 
 // SetTestConstructorInvocation configures Identifier.TestConstructor to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeIdentifier) SetTestConstructorInvocation(calls_sym5 []*IdentifierTestConstructorInvocation, fallback_sym5 func() string) {
-	f_sym5.TestConstructorHook = func(val int64) (t string) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Val, val) {
-				t = call_sym5.Results.T
+func (f_sym7 *FakeIdentifier) SetTestConstructorInvocation(calls_sym7 []*IdentifierTestConstructorInvocation, fallback_sym7 func() string) {
+	f_sym7.TestConstructorHook = func(val int64) (t string) {
+		for _, call_sym7 := range calls_sym7 {
+			if reflect.DeepEqual(call_sym7.Parameters.Val, val) {
+				t = call_sym7.Results.T
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback_sym7()
 	}
 }
 
@@ -228,9 +267,9 @@ func (f *FakeIdentifier) AssertTestConstructorCalledN(t IdentifierTestingT, n in
 }
 
 // TestConstructorCalledWith returns true if FakeIdentifier.TestConstructor was called with the given values
-func (f_sym6 *FakeIdentifier) TestConstructorCalledWith(val int64) bool {
-	for _, call_sym6 := range f_sym6.TestConstructorCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Val, val) {
+func (f_sym8 *FakeIdentifier) TestConstructorCalledWith(val int64) bool {
+	for _, call_sym8 := range f_sym8.TestConstructorCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.Val, val) {
 			return true
 		}
 	}
@@ -239,54 +278,54 @@ func (f_sym6 *FakeIdentifier) TestConstructorCalledWith(val int64) bool {
 }
 
 // AssertTestConstructorCalledWith calls t.Error if FakeIdentifier.TestConstructor was not called with the given values
-func (f_sym7 *FakeIdentifier) AssertTestConstructorCalledWith(t IdentifierTestingT, val int64) {
+func (f_sym9 *FakeIdentifier) AssertTestConstructorCalledWith(t IdentifierTestingT, val int64) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.TestConstructorCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Val, val) {
-			found_sym7 = true
+	var found_sym9 bool
+	for _, call_sym9 := range f_sym9.TestConstructorCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Val, val) {
+			found_sym9 = true
 			break
 		}
 	}
 
-	if !found_sym7 {
+	if !found_sym9 {
 		t.Error("FakeIdentifier.TestConstructor not called with expected parameters")
 	}
 }
 
 // TestConstructorCalledOnceWith returns true if FakeIdentifier.TestConstructor was called exactly once with the given values
-func (f_sym8 *FakeIdentifier) TestConstructorCalledOnceWith(val int64) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.TestConstructorCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Val, val) {
-			count_sym8++
+func (f_sym10 *FakeIdentifier) TestConstructorCalledOnceWith(val int64) bool {
+	var count_sym10 int
+	for _, call_sym10 := range f_sym10.TestConstructorCalls {
+		if reflect.DeepEqual(call_sym10.Parameters.Val, val) {
+			count_sym10++
 		}
 	}
 
-	return count_sym8 == 1
+	return count_sym10 == 1
 }
 
 // AssertTestConstructorCalledOnceWith calls t.Error if FakeIdentifier.TestConstructor was not called exactly once with the given values
-func (f_sym9 *FakeIdentifier) AssertTestConstructorCalledOnceWith(t IdentifierTestingT, val int64) {
+func (f_sym11 *FakeIdentifier) AssertTestConstructorCalledOnceWith(t IdentifierTestingT, val int64) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.TestConstructorCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Val, val) {
-			count_sym9++
+	var count_sym11 int
+	for _, call_sym11 := range f_sym11.TestConstructorCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.Val, val) {
+			count_sym11++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeIdentifier.TestConstructor called %d times with expected parameters, expected one", count_sym9)
+	if count_sym11 != 1 {
+		t.Errorf("FakeIdentifier.TestConstructor called %d times with expected parameters, expected one", count_sym11)
 	}
 }
 
 // TestConstructorResultsForCall returns the result values for the first call to FakeIdentifier.TestConstructor with the given values
-func (f_sym10 *FakeIdentifier) TestConstructorResultsForCall(val int64) (t string, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.TestConstructorCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Val, val) {
-			t = call_sym10.Results.T
-			found_sym10 = true
+func (f_sym12 *FakeIdentifier) TestConstructorResultsForCall(val int64) (t string, found_sym12 bool) {
+	for _, call_sym12 := range f_sym12.TestConstructorCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.Val, val) {
+			t = call_sym12.Results.T
+			found_sym12 = true
 			break
 		}
 	}
@@ -294,47 +333,48 @@ func (f_sym10 *FakeIdentifier) TestConstructorResultsForCall(val int64) (t strin
 	return
 }
 
-func (f_sym11 *FakeIdentifier) InvocationSetter(val int64) (call string, calls string, fallback string) {
-	if f_sym11.InvocationSetterHook == nil {
+// Issue #21 named return identifer conflicts with Set{Method}Invocation parameter(s)
+func (f_sym13 *FakeIdentifier) InvocationSetter(val int64) (call string, calls string, fallback string) {
+	if f_sym13.InvocationSetterHook == nil {
 		panic("Identifier.InvocationSetter() called but FakeIdentifier.InvocationSetterHook is nil")
 	}
 
-	invocation_sym11 := new(IdentifierInvocationSetterInvocation)
-	f_sym11.InvocationSetterCalls = append(f_sym11.InvocationSetterCalls, invocation_sym11)
+	invocation_sym13 := new(IdentifierInvocationSetterInvocation)
+	f_sym13.InvocationSetterCalls = append(f_sym13.InvocationSetterCalls, invocation_sym13)
 
-	invocation_sym11.Parameters.Val = val
+	invocation_sym13.Parameters.Val = val
 
-	call, calls, fallback = f_sym11.InvocationSetterHook(val)
+	call, calls, fallback = f_sym13.InvocationSetterHook(val)
 
-	invocation_sym11.Results.Call = call
-	invocation_sym11.Results.Calls = calls
-	invocation_sym11.Results.Fallback = fallback
+	invocation_sym13.Results.Call = call
+	invocation_sym13.Results.Calls = calls
+	invocation_sym13.Results.Fallback = fallback
 
 	return
 }
 
 // SetInvocationSetterStub configures Identifier.InvocationSetter to always return the given values
-func (f_sym12 *FakeIdentifier) SetInvocationSetterStub(call string, calls string, fallback string) {
-	f_sym12.InvocationSetterHook = func(int64) (string, string, string) {
+func (f_sym14 *FakeIdentifier) SetInvocationSetterStub(call string, calls string, fallback string) {
+	f_sym14.InvocationSetterHook = func(int64) (string, string, string) {
 		return call, calls, fallback
 	}
 }
 
 // SetInvocationSetterInvocation configures Identifier.InvocationSetter to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeIdentifier) SetInvocationSetterInvocation(calls_sym13 []*IdentifierInvocationSetterInvocation, fallback_sym13 func() (string, string, string)) {
-	f_sym13.InvocationSetterHook = func(val int64) (call string, calls string, fallback string) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.Val, val) {
-				call = call_sym13.Results.Call
-				calls = call_sym13.Results.Calls
-				fallback = call_sym13.Results.Fallback
+func (f_sym15 *FakeIdentifier) SetInvocationSetterInvocation(calls_sym15 []*IdentifierInvocationSetterInvocation, fallback_sym15 func() (string, string, string)) {
+	f_sym15.InvocationSetterHook = func(val int64) (call string, calls string, fallback string) {
+		for _, call_sym15 := range calls_sym15 {
+			if reflect.DeepEqual(call_sym15.Parameters.Val, val) {
+				call = call_sym15.Results.Call
+				calls = call_sym15.Results.Calls
+				fallback = call_sym15.Results.Fallback
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback_sym15()
 	}
 }
 
@@ -391,9 +431,9 @@ func (f *FakeIdentifier) AssertInvocationSetterCalledN(t IdentifierTestingT, n i
 }
 
 // InvocationSetterCalledWith returns true if FakeIdentifier.InvocationSetter was called with the given values
-func (f_sym14 *FakeIdentifier) InvocationSetterCalledWith(val int64) bool {
-	for _, call_sym14 := range f_sym14.InvocationSetterCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.Val, val) {
+func (f_sym16 *FakeIdentifier) InvocationSetterCalledWith(val int64) bool {
+	for _, call_sym16 := range f_sym16.InvocationSetterCalls {
+		if reflect.DeepEqual(call_sym16.Parameters.Val, val) {
 			return true
 		}
 	}
@@ -402,56 +442,56 @@ func (f_sym14 *FakeIdentifier) InvocationSetterCalledWith(val int64) bool {
 }
 
 // AssertInvocationSetterCalledWith calls t.Error if FakeIdentifier.InvocationSetter was not called with the given values
-func (f_sym15 *FakeIdentifier) AssertInvocationSetterCalledWith(t IdentifierTestingT, val int64) {
+func (f_sym17 *FakeIdentifier) AssertInvocationSetterCalledWith(t IdentifierTestingT, val int64) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.InvocationSetterCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.Val, val) {
-			found_sym15 = true
+	var found_sym17 bool
+	for _, call_sym17 := range f_sym17.InvocationSetterCalls {
+		if reflect.DeepEqual(call_sym17.Parameters.Val, val) {
+			found_sym17 = true
 			break
 		}
 	}
 
-	if !found_sym15 {
+	if !found_sym17 {
 		t.Error("FakeIdentifier.InvocationSetter not called with expected parameters")
 	}
 }
 
 // InvocationSetterCalledOnceWith returns true if FakeIdentifier.InvocationSetter was called exactly once with the given values
-func (f_sym16 *FakeIdentifier) InvocationSetterCalledOnceWith(val int64) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.InvocationSetterCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.Val, val) {
-			count_sym16++
+func (f_sym18 *FakeIdentifier) InvocationSetterCalledOnceWith(val int64) bool {
+	var count_sym18 int
+	for _, call_sym18 := range f_sym18.InvocationSetterCalls {
+		if reflect.DeepEqual(call_sym18.Parameters.Val, val) {
+			count_sym18++
 		}
 	}
 
-	return count_sym16 == 1
+	return count_sym18 == 1
 }
 
 // AssertInvocationSetterCalledOnceWith calls t.Error if FakeIdentifier.InvocationSetter was not called exactly once with the given values
-func (f_sym17 *FakeIdentifier) AssertInvocationSetterCalledOnceWith(t IdentifierTestingT, val int64) {
+func (f_sym19 *FakeIdentifier) AssertInvocationSetterCalledOnceWith(t IdentifierTestingT, val int64) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.InvocationSetterCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.Val, val) {
-			count_sym17++
+	var count_sym19 int
+	for _, call_sym19 := range f_sym19.InvocationSetterCalls {
+		if reflect.DeepEqual(call_sym19.Parameters.Val, val) {
+			count_sym19++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeIdentifier.InvocationSetter called %d times with expected parameters, expected one", count_sym17)
+	if count_sym19 != 1 {
+		t.Errorf("FakeIdentifier.InvocationSetter called %d times with expected parameters, expected one", count_sym19)
 	}
 }
 
 // InvocationSetterResultsForCall returns the result values for the first call to FakeIdentifier.InvocationSetter with the given values
-func (f_sym18 *FakeIdentifier) InvocationSetterResultsForCall(val int64) (call string, calls string, fallback string, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.InvocationSetterCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.Val, val) {
-			call = call_sym18.Results.Call
-			calls = call_sym18.Results.Calls
-			fallback = call_sym18.Results.Fallback
-			found_sym18 = true
+func (f_sym20 *FakeIdentifier) InvocationSetterResultsForCall(val int64) (call string, calls string, fallback string, found_sym20 bool) {
+	for _, call_sym20 := range f_sym20.InvocationSetterCalls {
+		if reflect.DeepEqual(call_sym20.Parameters.Val, val) {
+			call = call_sym20.Results.Call
+			calls = call_sym20.Results.Calls
+			fallback = call_sym20.Results.Fallback
+			found_sym20 = true
 			break
 		}
 	}
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/importer -output=testdata/importer/importer.go Importer".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/importer -output=testdata/importer/importer.go Importer".  DO NOT EDIT.
 
 package main
 
@@ -9,20 +9,20 @@ import z "strings"
 // ImporterScanInvocation represents a single call of FakeImporter.Scan
 type ImporterScanInvocation struct {
 	Parameters struct {
-		Ident1 *Scanner
+		Scanner *Scanner
 	}
 	Results struct {
-		Ident2 z.Reader
+		Reader z.Reader
 	}
 }
 
 // NewImporterScanInvocation creates a new instance of ImporterScanInvocation
-func NewImporterScanInvocation(ident1 *Scanner, ident2 z.Reader) *ImporterScanInvocation {
+func NewImporterScanInvocation(scanner *Scanner, reader z.Reader) *ImporterScanInvocation {
 	invocation := new(ImporterScanInvocation)
 
-	invocation.Parameters.Ident1 = ident1
+	invocation.Parameters.Scanner = scanner
 
-	invocation.Results.Ident2 = ident2
+	invocation.Results.Reader = reader
 
 	return invocation
 }
@@ -43,7 +43,7 @@ Use it in your tests as in this example:
 
 	func TestWithImporter(t *testing.T) {
 		f := &main.FakeImporter{
-			ScanHook: func(ident1 *Scanner) (ident2 z.Reader) {
+			ScanHook: func(scanner *Scanner) (reader z.Reader) {
 				// ensure parameters meet expectations, signal errors using t, etc
 				return
 			},
@@ -51,13 +51,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeScan ...
+		// assert state of FakeImporter ...
 		f.AssertScanCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeScan.
+unexpected calls are made to FakeImporter.
 */
 type FakeImporter struct {
 	ScanHook func(*Scanner) z.Reader
@@ -65,10 +65,12 @@ type FakeImporter struct {
 	ScanCalls []*ImporterScanInvocation
 }
 
+var _ Importer = (*FakeImporter)(nil)
+
 // NewFakeImporterDefaultPanic returns an instance of FakeImporter with all hooks configured to panic
 func NewFakeImporterDefaultPanic() *FakeImporter {
 	return &FakeImporter{
-		ScanHook: func(*Scanner) (ident2 z.Reader) {
+		ScanHook: func(*Scanner) (reader z.Reader) {
 			panic("Unexpected call to Importer.Scan")
 		},
 	}
@@ -77,7 +79,7 @@ func NewFakeImporterDefaultPanic() *FakeImporter {
 // NewFakeImporterDefaultFatal returns an instance of FakeImporter with all hooks configured to call t.Fatal
 func NewFakeImporterDefaultFatal(t_sym1 ImporterTestingT) *FakeImporter {
 	return &FakeImporter{
-		ScanHook: func(*Scanner) (ident2 z.Reader) {
+		ScanHook: func(*Scanner) (reader z.Reader) {
 			t_sym1.Fatal("Unexpected call to Importer.Scan")
 			return
 		},
@@ -87,54 +89,81 @@ func NewFakeImporterDefaultFatal(t_sym1 ImporterTestingT) *FakeImporter {
 // NewFakeImporterDefaultError returns an instance of FakeImporter with all hooks configured to call t.Error
 func NewFakeImporterDefaultError(t_sym2 ImporterTestingT) *FakeImporter {
 	return &FakeImporter{
-		ScanHook: func(*Scanner) (ident2 z.Reader) {
+		ScanHook: func(*Scanner) (reader z.Reader) {
 			t_sym2.Error("Unexpected call to Importer.Scan")
 			return
 		},
 	}
 }
 
+// NewFakeImporterDefaultZero returns an instance of FakeImporter with all hooks configured to return zero values
+func NewFakeImporterDefaultZero() *FakeImporter {
+	return &FakeImporter{
+		ScanHook: func(*Scanner) (reader z.Reader) {
+			return
+		},
+	}
+}
+
+// NewFakeImporterDefaultErr returns an instance of FakeImporter with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeImporterDefaultErr(err_sym3 error) *FakeImporter {
+	return &FakeImporter{
+		ScanHook: func(*Scanner) (reader z.Reader) {
+			return
+		},
+	}
+}
+
+// NewFakeImporterSpy returns an instance of FakeImporter with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeImporterSpy(real_sym4 Importer) *FakeImporter {
+	return &FakeImporter{
+		ScanHook: real_sym4.Scan,
+	}
+}
+
 func (f *FakeImporter) Reset() {
 	f.ScanCalls = []*ImporterScanInvocation{}
 }
 
-func (f_sym3 *FakeImporter) Scan(ident1 *Scanner) (ident2 z.Reader) {
-	if f_sym3.ScanHook == nil {
+func (f_sym5 *FakeImporter) Scan(scanner *Scanner) (reader z.Reader) {
+	if f_sym5.ScanHook == nil {
 		panic("Importer.Scan() called but FakeImporter.ScanHook is nil")
 	}
 
-	invocation_sym3 := new(ImporterScanInvocation)
-	f_sym3.ScanCalls = append(f_sym3.ScanCalls, invocation_sym3)
+	invocation_sym5 := new(ImporterScanInvocation)
+	f_sym5.ScanCalls = append(f_sym5.ScanCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation_sym5.Parameters.Scanner = scanner
 
-	ident2 = f_sym3.ScanHook(ident1)
+	reader = f_sym5.ScanHook(scanner)
 
-	invocation_sym3.Results.Ident2 = ident2
+	invocation_sym5.Results.Reader = reader
 
 	return
 }
 
 // SetScanStub configures Importer.Scan to always return the given values
-func (f_sym4 *FakeImporter) SetScanStub(ident2 z.Reader) {
-	f_sym4.ScanHook = func(*Scanner) z.Reader {
-		return ident2
+func (f_sym6 *FakeImporter) SetScanStub(reader z.Reader) {
+	f_sym6.ScanHook = func(*Scanner) z.Reader {
+		return reader
 	}
 }
 
 // SetScanInvocation configures Importer.Scan to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeImporter) SetScanInvocation(calls_sym5 []*ImporterScanInvocation, fallback_sym5 func() z.Reader) {
-	f_sym5.ScanHook = func(ident1 *Scanner) (ident2 z.Reader) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+func (f_sym7 *FakeImporter) SetScanInvocation(calls_sym7 []*ImporterScanInvocation, fallback_sym7 func() z.Reader) {
+	f_sym7.ScanHook = func(scanner *Scanner) (reader z.Reader) {
+		for _, call_sym7 := range calls_sym7 {
+			if reflect.DeepEqual(call_sym7.Parameters.Scanner, scanner) {
+				reader = call_sym7.Results.Reader
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback_sym7()
 	}
 }
 
@@ -191,9 +220,9 @@ func (f *FakeImporter) AssertScanCalledN(t ImporterTestingT, n int) {
 }
 
 // ScanCalledWith returns true if FakeImporter.Scan was called with the given values
-func (f_sym6 *FakeImporter) ScanCalledWith(ident1 *Scanner) bool {
-	for _, call_sym6 := range f_sym6.ScanCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+func (f_sym8 *FakeImporter) ScanCalledWith(scanner *Scanner) bool {
+	for _, call_sym8 := range f_sym8.ScanCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.Scanner, scanner) {
 			return true
 		}
 	}
@@ -202,54 +231,54 @@ func (f_sym6 *FakeImporter) ScanCalledWith(ident1 *Scanner) bool {
 }
 
 // AssertScanCalledWith calls t.Error if FakeImporter.Scan was not called with the given values
-func (f_sym7 *FakeImporter) AssertScanCalledWith(t ImporterTestingT, ident1 *Scanner) {
+func (f_sym9 *FakeImporter) AssertScanCalledWith(t ImporterTestingT, scanner *Scanner) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.ScanCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	var found_sym9 bool
+	for _, call_sym9 := range f_sym9.ScanCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Scanner, scanner) {
+			found_sym9 = true
 			break
 		}
 	}
 
-	if !found_sym7 {
+	if !found_sym9 {
 		t.Error("FakeImporter.Scan not called with expected parameters")
 	}
 }
 
 // ScanCalledOnceWith returns true if FakeImporter.Scan was called exactly once with the given values
-func (f_sym8 *FakeImporter) ScanCalledOnceWith(ident1 *Scanner) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.ScanCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+func (f_sym10 *FakeImporter) ScanCalledOnceWith(scanner *Scanner) bool {
+	var count_sym10 int
+	for _, call_sym10 := range f_sym10.ScanCalls {
+		if reflect.DeepEqual(call_sym10.Parameters.Scanner, scanner) {
+			count_sym10++
 		}
 	}
 
-	return count_sym8 == 1
+	return count_sym10 == 1
 }
 
 // AssertScanCalledOnceWith calls t.Error if FakeImporter.Scan was not called exactly once with the given values
-func (f_sym9 *FakeImporter) AssertScanCalledOnceWith(t ImporterTestingT, ident1 *Scanner) {
+func (f_sym11 *FakeImporter) AssertScanCalledOnceWith(t ImporterTestingT, scanner *Scanner) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.ScanCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	var count_sym11 int
+	for _, call_sym11 := range f_sym11.ScanCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.Scanner, scanner) {
+			count_sym11++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeImporter.Scan called %d times with expected parameters, expected one", count_sym9)
+	if count_sym11 != 1 {
+		t.Errorf("FakeImporter.Scan called %d times with expected parameters, expected one", count_sym11)
 	}
 }
 
 // ScanResultsForCall returns the result values for the first call to FakeImporter.Scan with the given values
-func (f_sym10 *FakeImporter) ScanResultsForCall(ident1 *Scanner) (ident2 z.Reader, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.ScanCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+func (f_sym12 *FakeImporter) ScanResultsForCall(scanner *Scanner) (reader z.Reader, found_sym12 bool) {
+	for _, call_sym12 := range f_sym12.ScanCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.Scanner, scanner) {
+			reader = call_sym12.Results.Reader
+			found_sym12 = true
 			break
 		}
 	}
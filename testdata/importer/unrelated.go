@@ -0,0 +1,6 @@
+package main
+
+import "reflect"
+
+// unrelated imports a package that the interfaces do not refer to
+var unrelated = reflect.TypeOf(0)
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/interfacer -output=testdata/interfacer/interfacer.go Interfacer".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/interfacer -output=testdata/interfacer/interfacer.go Interfacer".  DO NOT EDIT.
 
 package main
 
@@ -70,13 +70,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeInterface ...
+		// assert state of FakeInterfacer ...
 		f.AssertInterfaceCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeInterface.
+unexpected calls are made to FakeInterfacer.
 */
 type FakeInterfacer struct {
 	InterfaceHook      func(interface{}) interface{}
@@ -86,6 +86,8 @@ type FakeInterfacer struct {
 	NamedInterfaceCalls []*InterfacerNamedInterfaceInvocation
 }
 
+var _ Interfacer = (*FakeInterfacer)(nil)
+
 // NewFakeInterfacerDefaultPanic returns an instance of FakeInterfacer with all hooks configured to panic
 func NewFakeInterfacerDefaultPanic() *FakeInterfacer {
 	return &FakeInterfacer{
@@ -126,48 +128,82 @@ func NewFakeInterfacerDefaultError(t_sym2 InterfacerTestingT) *FakeInterfacer {
 	}
 }
 
+// NewFakeInterfacerDefaultZero returns an instance of FakeInterfacer with all hooks configured to return zero values
+func NewFakeInterfacerDefaultZero() *FakeInterfacer {
+	return &FakeInterfacer{
+		InterfaceHook: func(interface{}) (ident2 interface{}) {
+			return
+		},
+		NamedInterfaceHook: func(interface{}) (z interface{}) {
+			return
+		},
+	}
+}
+
+// NewFakeInterfacerDefaultErr returns an instance of FakeInterfacer with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeInterfacerDefaultErr(err_sym3 error) *FakeInterfacer {
+	return &FakeInterfacer{
+		InterfaceHook: func(interface{}) (ident2 interface{}) {
+			return
+		},
+		NamedInterfaceHook: func(interface{}) (z interface{}) {
+			return
+		},
+	}
+}
+
+// NewFakeInterfacerSpy returns an instance of FakeInterfacer with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeInterfacerSpy(real_sym4 Interfacer) *FakeInterfacer {
+	return &FakeInterfacer{
+		InterfaceHook:      real_sym4.Interface,
+		NamedInterfaceHook: real_sym4.NamedInterface,
+	}
+}
+
 func (f *FakeInterfacer) Reset() {
 	f.InterfaceCalls = []*InterfacerInterfaceInvocation{}
 	f.NamedInterfaceCalls = []*InterfacerNamedInterfaceInvocation{}
 }
 
-func (f_sym3 *FakeInterfacer) Interface(ident1 interface{}) (ident2 interface{}) {
-	if f_sym3.InterfaceHook == nil {
+func (f_sym5 *FakeInterfacer) Interface(ident1 interface{}) (ident2 interface{}) {
+	if f_sym5.InterfaceHook == nil {
 		panic("Interfacer.Interface() called but FakeInterfacer.InterfaceHook is nil")
 	}
 
-	invocation_sym3 := new(InterfacerInterfaceInvocation)
-	f_sym3.InterfaceCalls = append(f_sym3.InterfaceCalls, invocation_sym3)
+	invocation_sym5 := new(InterfacerInterfaceInvocation)
+	f_sym5.InterfaceCalls = append(f_sym5.InterfaceCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation_sym5.Parameters.Ident1 = ident1
 
-	ident2 = f_sym3.InterfaceHook(ident1)
+	ident2 = f_sym5.InterfaceHook(ident1)
 
-	invocation_sym3.Results.Ident2 = ident2
+	invocation_sym5.Results.Ident2 = ident2
 
 	return
 }
 
 // SetInterfaceStub configures Interfacer.Interface to always return the given values
-func (f_sym4 *FakeInterfacer) SetInterfaceStub(ident2 interface{}) {
-	f_sym4.InterfaceHook = func(interface{}) interface{} {
+func (f_sym6 *FakeInterfacer) SetInterfaceStub(ident2 interface{}) {
+	f_sym6.InterfaceHook = func(interface{}) interface{} {
 		return ident2
 	}
 }
 
 // SetInterfaceInvocation configures Interfacer.Interface to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeInterfacer) SetInterfaceInvocation(calls_sym5 []*InterfacerInterfaceInvocation, fallback_sym5 func() interface{}) {
-	f_sym5.InterfaceHook = func(ident1 interface{}) (ident2 interface{}) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+func (f_sym7 *FakeInterfacer) SetInterfaceInvocation(calls_sym7 []*InterfacerInterfaceInvocation, fallback_sym7 func() interface{}) {
+	f_sym7.InterfaceHook = func(ident1 interface{}) (ident2 interface{}) {
+		for _, call_sym7 := range calls_sym7 {
+			if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
+				ident2 = call_sym7.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback_sym7()
 	}
 }
 
@@ -224,9 +260,9 @@ func (f *FakeInterfacer) AssertInterfaceCalledN(t InterfacerTestingT, n int) {
 }
 
 // InterfaceCalledWith returns true if FakeInterfacer.Interface was called with the given values
-func (f_sym6 *FakeInterfacer) InterfaceCalledWith(ident1 interface{}) bool {
-	for _, call_sym6 := range f_sym6.InterfaceCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+func (f_sym8 *FakeInterfacer) InterfaceCalledWith(ident1 interface{}) bool {
+	for _, call_sym8 := range f_sym8.InterfaceCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -235,54 +271,54 @@ func (f_sym6 *FakeInterfacer) InterfaceCalledWith(ident1 interface{}) bool {
 }
 
 // AssertInterfaceCalledWith calls t.Error if FakeInterfacer.Interface was not called with the given values
-func (f_sym7 *FakeInterfacer) AssertInterfaceCalledWith(t InterfacerTestingT, ident1 interface{}) {
+func (f_sym9 *FakeInterfacer) AssertInterfaceCalledWith(t InterfacerTestingT, ident1 interface{}) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.InterfaceCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	var found_sym9 bool
+	for _, call_sym9 := range f_sym9.InterfaceCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
+			found_sym9 = true
 			break
 		}
 	}
 
-	if !found_sym7 {
+	if !found_sym9 {
 		t.Error("FakeInterfacer.Interface not called with expected parameters")
 	}
 }
 
 // InterfaceCalledOnceWith returns true if FakeInterfacer.Interface was called exactly once with the given values
-func (f_sym8 *FakeInterfacer) InterfaceCalledOnceWith(ident1 interface{}) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.InterfaceCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+func (f_sym10 *FakeInterfacer) InterfaceCalledOnceWith(ident1 interface{}) bool {
+	var count_sym10 int
+	for _, call_sym10 := range f_sym10.InterfaceCalls {
+		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
+			count_sym10++
 		}
 	}
 
-	return count_sym8 == 1
+	return count_sym10 == 1
 }
 
 // AssertInterfaceCalledOnceWith calls t.Error if FakeInterfacer.Interface was not called exactly once with the given values
-func (f_sym9 *FakeInterfacer) AssertInterfaceCalledOnceWith(t InterfacerTestingT, ident1 interface{}) {
+func (f_sym11 *FakeInterfacer) AssertInterfaceCalledOnceWith(t InterfacerTestingT, ident1 interface{}) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.InterfaceCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	var count_sym11 int
+	for _, call_sym11 := range f_sym11.InterfaceCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.Ident1, ident1) {
+			count_sym11++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeInterfacer.Interface called %d times with expected parameters, expected one", count_sym9)
+	if count_sym11 != 1 {
+		t.Errorf("FakeInterfacer.Interface called %d times with expected parameters, expected one", count_sym11)
 	}
 }
 
 // InterfaceResultsForCall returns the result values for the first call to FakeInterfacer.Interface with the given values
-func (f_sym10 *FakeInterfacer) InterfaceResultsForCall(ident1 interface{}) (ident2 interface{}, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.InterfaceCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+func (f_sym12 *FakeInterfacer) InterfaceResultsForCall(ident1 interface{}) (ident2 interface{}, found_sym12 bool) {
+	for _, call_sym12 := range f_sym12.InterfaceCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.Ident1, ident1) {
+			ident2 = call_sym12.Results.Ident2
+			found_sym12 = true
 			break
 		}
 	}
@@ -290,43 +326,43 @@ func (f_sym10 *FakeInterfacer) InterfaceResultsForCall(ident1 interface{}) (iden
 	return
 }
 
-func (f_sym11 *FakeInterfacer) NamedInterface(a interface{}) (z interface{}) {
-	if f_sym11.NamedInterfaceHook == nil {
+func (f_sym13 *FakeInterfacer) NamedInterface(a interface{}) (z interface{}) {
+	if f_sym13.NamedInterfaceHook == nil {
 		panic("Interfacer.NamedInterface() called but FakeInterfacer.NamedInterfaceHook is nil")
 	}
 
-	invocation_sym11 := new(InterfacerNamedInterfaceInvocation)
-	f_sym11.NamedInterfaceCalls = append(f_sym11.NamedInterfaceCalls, invocation_sym11)
+	invocation_sym13 := new(InterfacerNamedInterfaceInvocation)
+	f_sym13.NamedInterfaceCalls = append(f_sym13.NamedInterfaceCalls, invocation_sym13)
 
-	invocation_sym11.Parameters.A = a
+	invocation_sym13.Parameters.A = a
 
-	z = f_sym11.NamedInterfaceHook(a)
+	z = f_sym13.NamedInterfaceHook(a)
 
-	invocation_sym11.Results.Z = z
+	invocation_sym13.Results.Z = z
 
 	return
 }
 
 // SetNamedInterfaceStub configures Interfacer.NamedInterface to always return the given values
-func (f_sym12 *FakeInterfacer) SetNamedInterfaceStub(z interface{}) {
-	f_sym12.NamedInterfaceHook = func(interface{}) interface{} {
+func (f_sym14 *FakeInterfacer) SetNamedInterfaceStub(z interface{}) {
+	f_sym14.NamedInterfaceHook = func(interface{}) interface{} {
 		return z
 	}
 }
 
 // SetNamedInterfaceInvocation configures Interfacer.NamedInterface to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeInterfacer) SetNamedInterfaceInvocation(calls_sym13 []*InterfacerNamedInterfaceInvocation, fallback_sym13 func() interface{}) {
-	f_sym13.NamedInterfaceHook = func(a interface{}) (z interface{}) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.A, a) {
-				z = call_sym13.Results.Z
+func (f_sym15 *FakeInterfacer) SetNamedInterfaceInvocation(calls_sym15 []*InterfacerNamedInterfaceInvocation, fallback_sym15 func() interface{}) {
+	f_sym15.NamedInterfaceHook = func(a interface{}) (z interface{}) {
+		for _, call_sym15 := range calls_sym15 {
+			if reflect.DeepEqual(call_sym15.Parameters.A, a) {
+				z = call_sym15.Results.Z
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback_sym15()
 	}
 }
 
@@ -383,9 +419,9 @@ func (f *FakeInterfacer) AssertNamedInterfaceCalledN(t InterfacerTestingT, n int
 }
 
 // NamedInterfaceCalledWith returns true if FakeInterfacer.NamedInterface was called with the given values
-func (f_sym14 *FakeInterfacer) NamedInterfaceCalledWith(a interface{}) bool {
-	for _, call_sym14 := range f_sym14.NamedInterfaceCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.A, a) {
+func (f_sym16 *FakeInterfacer) NamedInterfaceCalledWith(a interface{}) bool {
+	for _, call_sym16 := range f_sym16.NamedInterfaceCalls {
+		if reflect.DeepEqual(call_sym16.Parameters.A, a) {
 			return true
 		}
 	}
@@ -394,54 +430,54 @@ func (f_sym14 *FakeInterfacer) NamedInterfaceCalledWith(a interface{}) bool {
 }
 
 // AssertNamedInterfaceCalledWith calls t.Error if FakeInterfacer.NamedInterface was not called with the given values
-func (f_sym15 *FakeInterfacer) AssertNamedInterfaceCalledWith(t InterfacerTestingT, a interface{}) {
+func (f_sym17 *FakeInterfacer) AssertNamedInterfaceCalledWith(t InterfacerTestingT, a interface{}) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.NamedInterfaceCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.A, a) {
-			found_sym15 = true
+	var found_sym17 bool
+	for _, call_sym17 := range f_sym17.NamedInterfaceCalls {
+		if reflect.DeepEqual(call_sym17.Parameters.A, a) {
+			found_sym17 = true
 			break
 		}
 	}
 
-	if !found_sym15 {
+	if !found_sym17 {
 		t.Error("FakeInterfacer.NamedInterface not called with expected parameters")
 	}
 }
 
 // NamedInterfaceCalledOnceWith returns true if FakeInterfacer.NamedInterface was called exactly once with the given values
-func (f_sym16 *FakeInterfacer) NamedInterfaceCalledOnceWith(a interface{}) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.NamedInterfaceCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.A, a) {
-			count_sym16++
+func (f_sym18 *FakeInterfacer) NamedInterfaceCalledOnceWith(a interface{}) bool {
+	var count_sym18 int
+	for _, call_sym18 := range f_sym18.NamedInterfaceCalls {
+		if reflect.DeepEqual(call_sym18.Parameters.A, a) {
+			count_sym18++
 		}
 	}
 
-	return count_sym16 == 1
+	return count_sym18 == 1
 }
 
 // AssertNamedInterfaceCalledOnceWith calls t.Error if FakeInterfacer.NamedInterface was not called exactly once with the given values
-func (f_sym17 *FakeInterfacer) AssertNamedInterfaceCalledOnceWith(t InterfacerTestingT, a interface{}) {
+func (f_sym19 *FakeInterfacer) AssertNamedInterfaceCalledOnceWith(t InterfacerTestingT, a interface{}) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.NamedInterfaceCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.A, a) {
-			count_sym17++
+	var count_sym19 int
+	for _, call_sym19 := range f_sym19.NamedInterfaceCalls {
+		if reflect.DeepEqual(call_sym19.Parameters.A, a) {
+			count_sym19++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeInterfacer.NamedInterface called %d times with expected parameters, expected one", count_sym17)
+	if count_sym19 != 1 {
+		t.Errorf("FakeInterfacer.NamedInterface called %d times with expected parameters, expected one", count_sym19)
 	}
 }
 
 // NamedInterfaceResultsForCall returns the result values for the first call to FakeInterfacer.NamedInterface with the given values
-func (f_sym18 *FakeInterfacer) NamedInterfaceResultsForCall(a interface{}) (z interface{}, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.NamedInterfaceCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.A, a) {
-			z = call_sym18.Results.Z
-			found_sym18 = true
+func (f_sym20 *FakeInterfacer) NamedInterfaceResultsForCall(a interface{}) (z interface{}, found_sym20 bool) {
+	for _, call_sym20 := range f_sym20.NamedInterfaceCalls {
+		if reflect.DeepEqual(call_sym20.Parameters.A, a) {
+			z = call_sym20.Results.Z
+			found_sym20 = true
 			break
 		}
 	}
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/mapper -output=testdata/mapper/mapper.go Mapper".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/mapper -output=testdata/mapper/mapper.go Mapper".  DO NOT EDIT.
 
 package main
 
@@ -42,13 +42,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeMapParameter ...
+		// assert state of FakeMapper ...
 		f.AssertMapParameterCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeMapParameter.
+unexpected calls are made to FakeMapper.
 */
 type FakeMapper struct {
 	MapParameterHook func(map[string]string)
@@ -58,6 +58,8 @@ type FakeMapper struct {
 	MapReturnCalls    []*MapperMapReturnInvocation
 }
 
+var _ Mapper = (*FakeMapper)(nil)
+
 // NewFakeMapperDefaultPanic returns an instance of FakeMapper with all hooks configured to panic
 func NewFakeMapperDefaultPanic() *FakeMapper {
 	return &FakeMapper{
@@ -98,22 +100,56 @@ func NewFakeMapperDefaultError(t_sym2 MapperTestingT) *FakeMapper {
 	}
 }
 
+// NewFakeMapperDefaultZero returns an instance of FakeMapper with all hooks configured to return zero values
+func NewFakeMapperDefaultZero() *FakeMapper {
+	return &FakeMapper{
+		MapParameterHook: func(map[string]string) {
+			return
+		},
+		MapReturnHook: func() (ident1 map[string]string) {
+			return
+		},
+	}
+}
+
+// NewFakeMapperDefaultErr returns an instance of FakeMapper with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeMapperDefaultErr(err_sym3 error) *FakeMapper {
+	return &FakeMapper{
+		MapParameterHook: func(map[string]string) {
+			return
+		},
+		MapReturnHook: func() (ident1 map[string]string) {
+			return
+		},
+	}
+}
+
+// NewFakeMapperSpy returns an instance of FakeMapper with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeMapperSpy(real_sym4 Mapper) *FakeMapper {
+	return &FakeMapper{
+		MapParameterHook: real_sym4.MapParameter,
+		MapReturnHook:    real_sym4.MapReturn,
+	}
+}
+
 func (f *FakeMapper) Reset() {
 	f.MapParameterCalls = []*MapperMapParameterInvocation{}
 	f.MapReturnCalls = []*MapperMapReturnInvocation{}
 }
 
-func (f_sym3 *FakeMapper) MapParameter(ident1 map[string]string) {
-	if f_sym3.MapParameterHook == nil {
+func (f_sym5 *FakeMapper) MapParameter(ident1 map[string]string) {
+	if f_sym5.MapParameterHook == nil {
 		panic("Mapper.MapParameter() called but FakeMapper.MapParameterHook is nil")
 	}
 
-	invocation_sym3 := new(MapperMapParameterInvocation)
-	f_sym3.MapParameterCalls = append(f_sym3.MapParameterCalls, invocation_sym3)
+	invocation_sym5 := new(MapperMapParameterInvocation)
+	f_sym5.MapParameterCalls = append(f_sym5.MapParameterCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation_sym5.Parameters.Ident1 = ident1
 
-	f_sym3.MapParameterHook(ident1)
+	f_sym5.MapParameterHook(ident1)
 
 	return
 }
@@ -171,9 +207,9 @@ func (f *FakeMapper) AssertMapParameterCalledN(t MapperTestingT, n int) {
 }
 
 // MapParameterCalledWith returns true if FakeMapper.MapParameter was called with the given values
-func (f_sym4 *FakeMapper) MapParameterCalledWith(ident1 map[string]string) bool {
-	for _, call_sym4 := range f_sym4.MapParameterCalls {
-		if reflect.DeepEqual(call_sym4.Parameters.Ident1, ident1) {
+func (f_sym6 *FakeMapper) MapParameterCalledWith(ident1 map[string]string) bool {
+	for _, call_sym6 := range f_sym6.MapParameterCalls {
+		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -182,66 +218,66 @@ func (f_sym4 *FakeMapper) MapParameterCalledWith(ident1 map[string]string) bool
 }
 
 // AssertMapParameterCalledWith calls t.Error if FakeMapper.MapParameter was not called with the given values
-func (f_sym5 *FakeMapper) AssertMapParameterCalledWith(t MapperTestingT, ident1 map[string]string) {
+func (f_sym7 *FakeMapper) AssertMapParameterCalledWith(t MapperTestingT, ident1 map[string]string) {
 	t.Helper()
-	var found_sym5 bool
-	for _, call_sym5 := range f_sym5.MapParameterCalls {
-		if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-			found_sym5 = true
+	var found_sym7 bool
+	for _, call_sym7 := range f_sym7.MapParameterCalls {
+		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
+			found_sym7 = true
 			break
 		}
 	}
 
-	if !found_sym5 {
+	if !found_sym7 {
 		t.Error("FakeMapper.MapParameter not called with expected parameters")
 	}
 }
 
 // MapParameterCalledOnceWith returns true if FakeMapper.MapParameter was called exactly once with the given values
-func (f_sym6 *FakeMapper) MapParameterCalledOnceWith(ident1 map[string]string) bool {
-	var count_sym6 int
-	for _, call_sym6 := range f_sym6.MapParameterCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
-			count_sym6++
+func (f_sym8 *FakeMapper) MapParameterCalledOnceWith(ident1 map[string]string) bool {
+	var count_sym8 int
+	for _, call_sym8 := range f_sym8.MapParameterCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
+			count_sym8++
 		}
 	}
 
-	return count_sym6 == 1
+	return count_sym8 == 1
 }
 
 // AssertMapParameterCalledOnceWith calls t.Error if FakeMapper.MapParameter was not called exactly once with the given values
-func (f_sym7 *FakeMapper) AssertMapParameterCalledOnceWith(t MapperTestingT, ident1 map[string]string) {
+func (f_sym9 *FakeMapper) AssertMapParameterCalledOnceWith(t MapperTestingT, ident1 map[string]string) {
 	t.Helper()
-	var count_sym7 int
-	for _, call_sym7 := range f_sym7.MapParameterCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			count_sym7++
+	var count_sym9 int
+	for _, call_sym9 := range f_sym9.MapParameterCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
+			count_sym9++
 		}
 	}
 
-	if count_sym7 != 1 {
-		t.Errorf("FakeMapper.MapParameter called %d times with expected parameters, expected one", count_sym7)
+	if count_sym9 != 1 {
+		t.Errorf("FakeMapper.MapParameter called %d times with expected parameters, expected one", count_sym9)
 	}
 }
 
-func (f_sym8 *FakeMapper) MapReturn() (ident1 map[string]string) {
-	if f_sym8.MapReturnHook == nil {
+func (f_sym10 *FakeMapper) MapReturn() (ident1 map[string]string) {
+	if f_sym10.MapReturnHook == nil {
 		panic("Mapper.MapReturn() called but FakeMapper.MapReturnHook is nil")
 	}
 
-	invocation_sym8 := new(MapperMapReturnInvocation)
-	f_sym8.MapReturnCalls = append(f_sym8.MapReturnCalls, invocation_sym8)
+	invocation_sym10 := new(MapperMapReturnInvocation)
+	f_sym10.MapReturnCalls = append(f_sym10.MapReturnCalls, invocation_sym10)
 
-	ident1 = f_sym8.MapReturnHook()
+	ident1 = f_sym10.MapReturnHook()
 
-	invocation_sym8.Results.Ident1 = ident1
+	invocation_sym10.Results.Ident1 = ident1
 
 	return
 }
 
 // SetMapReturnStub configures Mapper.MapReturn to always return the given values
-func (f_sym9 *FakeMapper) SetMapReturnStub(ident1 map[string]string) {
-	f_sym9.MapReturnHook = func() map[string]string {
+func (f_sym11 *FakeMapper) SetMapReturnStub(ident1 map[string]string) {
+	f_sym11.MapReturnHook = func() map[string]string {
 		return ident1
 	}
 }
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/multireturner -output=testdata/multireturner/multireturner.go Multireturner".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/multireturner -output=testdata/multireturner/multireturner.go Multireturner".  DO NOT EDIT.
 
 package main
 
@@ -44,13 +44,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeMultiReturn ...
+		// assert state of FakeMultireturner ...
 		f.AssertMultiReturnCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeMultiReturn.
+unexpected calls are made to FakeMultireturner.
 */
 type FakeMultireturner struct {
 	MultiReturnHook func() (string, int)
@@ -60,6 +60,8 @@ type FakeMultireturner struct {
 	NamedReturnCalls []*MultireturnerNamedReturnInvocation
 }
 
+var _ Multireturner = (*FakeMultireturner)(nil)
+
 // NewFakeMultireturnerDefaultPanic returns an instance of FakeMultireturner with all hooks configured to panic
 func NewFakeMultireturnerDefaultPanic() *FakeMultireturner {
 	return &FakeMultireturner{
@@ -100,30 +102,64 @@ func NewFakeMultireturnerDefaultError(t_sym2 MultireturnerTestingT) *FakeMultire
 	}
 }
 
+// NewFakeMultireturnerDefaultZero returns an instance of FakeMultireturner with all hooks configured to return zero values
+func NewFakeMultireturnerDefaultZero() *FakeMultireturner {
+	return &FakeMultireturner{
+		MultiReturnHook: func() (ident1 string, ident2 int) {
+			return
+		},
+		NamedReturnHook: func() (a int, b int, c int, d int) {
+			return
+		},
+	}
+}
+
+// NewFakeMultireturnerDefaultErr returns an instance of FakeMultireturner with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeMultireturnerDefaultErr(err_sym3 error) *FakeMultireturner {
+	return &FakeMultireturner{
+		MultiReturnHook: func() (ident1 string, ident2 int) {
+			return
+		},
+		NamedReturnHook: func() (a int, b int, c int, d int) {
+			return
+		},
+	}
+}
+
+// NewFakeMultireturnerSpy returns an instance of FakeMultireturner with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeMultireturnerSpy(real_sym4 Multireturner) *FakeMultireturner {
+	return &FakeMultireturner{
+		MultiReturnHook: real_sym4.MultiReturn,
+		NamedReturnHook: real_sym4.NamedReturn,
+	}
+}
+
 func (f *FakeMultireturner) Reset() {
 	f.MultiReturnCalls = []*MultireturnerMultiReturnInvocation{}
 	f.NamedReturnCalls = []*MultireturnerNamedReturnInvocation{}
 }
 
-func (f_sym3 *FakeMultireturner) MultiReturn() (ident1 string, ident2 int) {
-	if f_sym3.MultiReturnHook == nil {
+func (f_sym5 *FakeMultireturner) MultiReturn() (ident1 string, ident2 int) {
+	if f_sym5.MultiReturnHook == nil {
 		panic("Multireturner.MultiReturn() called but FakeMultireturner.MultiReturnHook is nil")
 	}
 
-	invocation_sym3 := new(MultireturnerMultiReturnInvocation)
-	f_sym3.MultiReturnCalls = append(f_sym3.MultiReturnCalls, invocation_sym3)
+	invocation_sym5 := new(MultireturnerMultiReturnInvocation)
+	f_sym5.MultiReturnCalls = append(f_sym5.MultiReturnCalls, invocation_sym5)
 
-	ident1, ident2 = f_sym3.MultiReturnHook()
+	ident1, ident2 = f_sym5.MultiReturnHook()
 
-	invocation_sym3.Results.Ident1 = ident1
-	invocation_sym3.Results.Ident2 = ident2
+	invocation_sym5.Results.Ident1 = ident1
+	invocation_sym5.Results.Ident2 = ident2
 
 	return
 }
 
 // SetMultiReturnStub configures Multireturner.MultiReturn to always return the given values
-func (f_sym4 *FakeMultireturner) SetMultiReturnStub(ident1 string, ident2 int) {
-	f_sym4.MultiReturnHook = func() (string, int) {
+func (f_sym6 *FakeMultireturner) SetMultiReturnStub(ident1 string, ident2 int) {
+	f_sym6.MultiReturnHook = func() (string, int) {
 		return ident1, ident2
 	}
 }
@@ -180,27 +216,27 @@ func (f *FakeMultireturner) AssertMultiReturnCalledN(t MultireturnerTestingT, n
 	}
 }
 
-func (f_sym5 *FakeMultireturner) NamedReturn() (a int, b int, c int, d int) {
-	if f_sym5.NamedReturnHook == nil {
+func (f_sym7 *FakeMultireturner) NamedReturn() (a int, b int, c int, d int) {
+	if f_sym7.NamedReturnHook == nil {
 		panic("Multireturner.NamedReturn() called but FakeMultireturner.NamedReturnHook is nil")
 	}
 
-	invocation_sym5 := new(MultireturnerNamedReturnInvocation)
-	f_sym5.NamedReturnCalls = append(f_sym5.NamedReturnCalls, invocation_sym5)
+	invocation_sym7 := new(MultireturnerNamedReturnInvocation)
+	f_sym7.NamedReturnCalls = append(f_sym7.NamedReturnCalls, invocation_sym7)
 
-	a, b, c, d = f_sym5.NamedReturnHook()
+	a, b, c, d = f_sym7.NamedReturnHook()
 
-	invocation_sym5.Results.A = a
-	invocation_sym5.Results.B = b
-	invocation_sym5.Results.C = c
-	invocation_sym5.Results.D = d
+	invocation_sym7.Results.A = a
+	invocation_sym7.Results.B = b
+	invocation_sym7.Results.C = c
+	invocation_sym7.Results.D = d
 
 	return
 }
 
 // SetNamedReturnStub configures Multireturner.NamedReturn to always return the given values
-func (f_sym6 *FakeMultireturner) SetNamedReturnStub(a int, b int, c int, d int) {
-	f_sym6.NamedReturnHook = func() (int, int, int, int) {
+func (f_sym8 *FakeMultireturner) SetNamedReturnStub(a int, b int, c int, d int) {
+	f_sym8.NamedReturnHook = func() (int, int, int, int) {
 		return a, b, c, d
 	}
 }
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/namedvaluer -output=testdata/namedvaluer/namedvaluer.go Namedvaluer".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/namedvaluer -output=testdata/namedvaluer/namedvaluer.go Namedvaluer".  DO NOT EDIT.
 
 package main
 
@@ -78,13 +78,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeManyNamed ...
+		// assert state of FakeNamedvaluer ...
 		f.AssertManyNamedCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeManyNamed.
+unexpected calls are made to FakeNamedvaluer.
 */
 type FakeNamedvaluer struct {
 	ManyNamedHook func(string, string, int, int) bool
@@ -94,6 +94,8 @@ type FakeNamedvaluer struct {
 	NamedCalls     []*NamedvaluerNamedInvocation
 }
 
+var _ Namedvaluer = (*FakeNamedvaluer)(nil)
+
 // NewFakeNamedvaluerDefaultPanic returns an instance of FakeNamedvaluer with all hooks configured to panic
 func NewFakeNamedvaluerDefaultPanic() *FakeNamedvaluer {
 	return &FakeNamedvaluer{
@@ -134,51 +136,85 @@ func NewFakeNamedvaluerDefaultError(t_sym2 NamedvaluerTestingT) *FakeNamedvaluer
 	}
 }
 
+// NewFakeNamedvaluerDefaultZero returns an instance of FakeNamedvaluer with all hooks configured to return zero values
+func NewFakeNamedvaluerDefaultZero() *FakeNamedvaluer {
+	return &FakeNamedvaluer{
+		ManyNamedHook: func(string, string, int, int) (ret bool) {
+			return
+		},
+		NamedHook: func(int, string) (ret bool) {
+			return
+		},
+	}
+}
+
+// NewFakeNamedvaluerDefaultErr returns an instance of FakeNamedvaluer with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeNamedvaluerDefaultErr(err_sym3 error) *FakeNamedvaluer {
+	return &FakeNamedvaluer{
+		ManyNamedHook: func(string, string, int, int) (ret bool) {
+			return
+		},
+		NamedHook: func(int, string) (ret bool) {
+			return
+		},
+	}
+}
+
+// NewFakeNamedvaluerSpy returns an instance of FakeNamedvaluer with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeNamedvaluerSpy(real_sym4 Namedvaluer) *FakeNamedvaluer {
+	return &FakeNamedvaluer{
+		ManyNamedHook: real_sym4.ManyNamed,
+		NamedHook:     real_sym4.Named,
+	}
+}
+
 func (f *FakeNamedvaluer) Reset() {
 	f.ManyNamedCalls = []*NamedvaluerManyNamedInvocation{}
 	f.NamedCalls = []*NamedvaluerNamedInvocation{}
 }
 
-func (f_sym3 *FakeNamedvaluer) ManyNamed(a string, b string, f int, g int) (ret bool) {
-	if f_sym3.ManyNamedHook == nil {
+func (f_sym5 *FakeNamedvaluer) ManyNamed(a string, b string, f int, g int) (ret bool) {
+	if f_sym5.ManyNamedHook == nil {
 		panic("Namedvaluer.ManyNamed() called but FakeNamedvaluer.ManyNamedHook is nil")
 	}
 
-	invocation_sym3 := new(NamedvaluerManyNamedInvocation)
-	f_sym3.ManyNamedCalls = append(f_sym3.ManyNamedCalls, invocation_sym3)
+	invocation_sym5 := new(NamedvaluerManyNamedInvocation)
+	f_sym5.ManyNamedCalls = append(f_sym5.ManyNamedCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.A = a
-	invocation_sym3.Parameters.B = b
-	invocation_sym3.Parameters.F = f
-	invocation_sym3.Parameters.G = g
+	invocation_sym5.Parameters.A = a
+	invocation_sym5.Parameters.B = b
+	invocation_sym5.Parameters.F = f
+	invocation_sym5.Parameters.G = g
 
-	ret = f_sym3.ManyNamedHook(a, b, f, g)
+	ret = f_sym5.ManyNamedHook(a, b, f, g)
 
-	invocation_sym3.Results.Ret = ret
+	invocation_sym5.Results.Ret = ret
 
 	return
 }
 
 // SetManyNamedStub configures Namedvaluer.ManyNamed to always return the given values
-func (f_sym4 *FakeNamedvaluer) SetManyNamedStub(ret bool) {
-	f_sym4.ManyNamedHook = func(string, string, int, int) bool {
+func (f_sym6 *FakeNamedvaluer) SetManyNamedStub(ret bool) {
+	f_sym6.ManyNamedHook = func(string, string, int, int) bool {
 		return ret
 	}
 }
 
 // SetManyNamedInvocation configures Namedvaluer.ManyNamed to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeNamedvaluer) SetManyNamedInvocation(calls_sym5 []*NamedvaluerManyNamedInvocation, fallback_sym5 func() bool) {
-	f_sym5.ManyNamedHook = func(a string, b string, f int, g int) (ret bool) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.A, a) && reflect.DeepEqual(call_sym5.Parameters.B, b) && reflect.DeepEqual(call_sym5.Parameters.F, f) && reflect.DeepEqual(call_sym5.Parameters.G, g) {
-				ret = call_sym5.Results.Ret
+func (f_sym7 *FakeNamedvaluer) SetManyNamedInvocation(calls_sym7 []*NamedvaluerManyNamedInvocation, fallback_sym7 func() bool) {
+	f_sym7.ManyNamedHook = func(a string, b string, f int, g int) (ret bool) {
+		for _, call_sym7 := range calls_sym7 {
+			if reflect.DeepEqual(call_sym7.Parameters.A, a) && reflect.DeepEqual(call_sym7.Parameters.B, b) && reflect.DeepEqual(call_sym7.Parameters.F, f) && reflect.DeepEqual(call_sym7.Parameters.G, g) {
+				ret = call_sym7.Results.Ret
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback_sym7()
 	}
 }
 
@@ -235,9 +271,9 @@ func (f *FakeNamedvaluer) AssertManyNamedCalledN(t NamedvaluerTestingT, n int) {
 }
 
 // ManyNamedCalledWith returns true if FakeNamedvaluer.ManyNamed was called with the given values
-func (f_sym6 *FakeNamedvaluer) ManyNamedCalledWith(a string, b string, f int, g int) bool {
-	for _, call_sym6 := range f_sym6.ManyNamedCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.A, a) && reflect.DeepEqual(call_sym6.Parameters.B, b) && reflect.DeepEqual(call_sym6.Parameters.F, f) && reflect.DeepEqual(call_sym6.Parameters.G, g) {
+func (f_sym8 *FakeNamedvaluer) ManyNamedCalledWith(a string, b string, f int, g int) bool {
+	for _, call_sym8 := range f_sym8.ManyNamedCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.A, a) && reflect.DeepEqual(call_sym8.Parameters.B, b) && reflect.DeepEqual(call_sym8.Parameters.F, f) && reflect.DeepEqual(call_sym8.Parameters.G, g) {
 			return true
 		}
 	}
@@ -246,54 +282,54 @@ func (f_sym6 *FakeNamedvaluer) ManyNamedCalledWith(a string, b string, f int, g
 }
 
 // AssertManyNamedCalledWith calls t.Error if FakeNamedvaluer.ManyNamed was not called with the given values
-func (f_sym7 *FakeNamedvaluer) AssertManyNamedCalledWith(t NamedvaluerTestingT, a string, b string, f int, g int) {
+func (f_sym9 *FakeNamedvaluer) AssertManyNamedCalledWith(t NamedvaluerTestingT, a string, b string, f int, g int) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.ManyNamedCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.A, a) && reflect.DeepEqual(call_sym7.Parameters.B, b) && reflect.DeepEqual(call_sym7.Parameters.F, f) && reflect.DeepEqual(call_sym7.Parameters.G, g) {
-			found_sym7 = true
+	var found_sym9 bool
+	for _, call_sym9 := range f_sym9.ManyNamedCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.A, a) && reflect.DeepEqual(call_sym9.Parameters.B, b) && reflect.DeepEqual(call_sym9.Parameters.F, f) && reflect.DeepEqual(call_sym9.Parameters.G, g) {
+			found_sym9 = true
 			break
 		}
 	}
 
-	if !found_sym7 {
+	if !found_sym9 {
 		t.Error("FakeNamedvaluer.ManyNamed not called with expected parameters")
 	}
 }
 
 // ManyNamedCalledOnceWith returns true if FakeNamedvaluer.ManyNamed was called exactly once with the given values
-func (f_sym8 *FakeNamedvaluer) ManyNamedCalledOnceWith(a string, b string, f int, g int) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.ManyNamedCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.A, a) && reflect.DeepEqual(call_sym8.Parameters.B, b) && reflect.DeepEqual(call_sym8.Parameters.F, f) && reflect.DeepEqual(call_sym8.Parameters.G, g) {
-			count_sym8++
+func (f_sym10 *FakeNamedvaluer) ManyNamedCalledOnceWith(a string, b string, f int, g int) bool {
+	var count_sym10 int
+	for _, call_sym10 := range f_sym10.ManyNamedCalls {
+		if reflect.DeepEqual(call_sym10.Parameters.A, a) && reflect.DeepEqual(call_sym10.Parameters.B, b) && reflect.DeepEqual(call_sym10.Parameters.F, f) && reflect.DeepEqual(call_sym10.Parameters.G, g) {
+			count_sym10++
 		}
 	}
 
-	return count_sym8 == 1
+	return count_sym10 == 1
 }
 
 // AssertManyNamedCalledOnceWith calls t.Error if FakeNamedvaluer.ManyNamed was not called exactly once with the given values
-func (f_sym9 *FakeNamedvaluer) AssertManyNamedCalledOnceWith(t NamedvaluerTestingT, a string, b string, f int, g int) {
+func (f_sym11 *FakeNamedvaluer) AssertManyNamedCalledOnceWith(t NamedvaluerTestingT, a string, b string, f int, g int) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.ManyNamedCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.A, a) && reflect.DeepEqual(call_sym9.Parameters.B, b) && reflect.DeepEqual(call_sym9.Parameters.F, f) && reflect.DeepEqual(call_sym9.Parameters.G, g) {
-			count_sym9++
+	var count_sym11 int
+	for _, call_sym11 := range f_sym11.ManyNamedCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.A, a) && reflect.DeepEqual(call_sym11.Parameters.B, b) && reflect.DeepEqual(call_sym11.Parameters.F, f) && reflect.DeepEqual(call_sym11.Parameters.G, g) {
+			count_sym11++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeNamedvaluer.ManyNamed called %d times with expected parameters, expected one", count_sym9)
+	if count_sym11 != 1 {
+		t.Errorf("FakeNamedvaluer.ManyNamed called %d times with expected parameters, expected one", count_sym11)
 	}
 }
 
 // ManyNamedResultsForCall returns the result values for the first call to FakeNamedvaluer.ManyNamed with the given values
-func (f_sym10 *FakeNamedvaluer) ManyNamedResultsForCall(a string, b string, f int, g int) (ret bool, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.ManyNamedCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.A, a) && reflect.DeepEqual(call_sym10.Parameters.B, b) && reflect.DeepEqual(call_sym10.Parameters.F, f) && reflect.DeepEqual(call_sym10.Parameters.G, g) {
-			ret = call_sym10.Results.Ret
-			found_sym10 = true
+func (f_sym12 *FakeNamedvaluer) ManyNamedResultsForCall(a string, b string, f int, g int) (ret bool, found_sym12 bool) {
+	for _, call_sym12 := range f_sym12.ManyNamedCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.A, a) && reflect.DeepEqual(call_sym12.Parameters.B, b) && reflect.DeepEqual(call_sym12.Parameters.F, f) && reflect.DeepEqual(call_sym12.Parameters.G, g) {
+			ret = call_sym12.Results.Ret
+			found_sym12 = true
 			break
 		}
 	}
@@ -301,44 +337,44 @@ func (f_sym10 *FakeNamedvaluer) ManyNamedResultsForCall(a string, b string, f in
 	return
 }
 
-func (f_sym11 *FakeNamedvaluer) Named(a int, b string) (ret bool) {
-	if f_sym11.NamedHook == nil {
+func (f_sym13 *FakeNamedvaluer) Named(a int, b string) (ret bool) {
+	if f_sym13.NamedHook == nil {
 		panic("Namedvaluer.Named() called but FakeNamedvaluer.NamedHook is nil")
 	}
 
-	invocation_sym11 := new(NamedvaluerNamedInvocation)
-	f_sym11.NamedCalls = append(f_sym11.NamedCalls, invocation_sym11)
+	invocation_sym13 := new(NamedvaluerNamedInvocation)
+	f_sym13.NamedCalls = append(f_sym13.NamedCalls, invocation_sym13)
 
-	invocation_sym11.Parameters.A = a
-	invocation_sym11.Parameters.B = b
+	invocation_sym13.Parameters.A = a
+	invocation_sym13.Parameters.B = b
 
-	ret = f_sym11.NamedHook(a, b)
+	ret = f_sym13.NamedHook(a, b)
 
-	invocation_sym11.Results.Ret = ret
+	invocation_sym13.Results.Ret = ret
 
 	return
 }
 
 // SetNamedStub configures Namedvaluer.Named to always return the given values
-func (f_sym12 *FakeNamedvaluer) SetNamedStub(ret bool) {
-	f_sym12.NamedHook = func(int, string) bool {
+func (f_sym14 *FakeNamedvaluer) SetNamedStub(ret bool) {
+	f_sym14.NamedHook = func(int, string) bool {
 		return ret
 	}
 }
 
 // SetNamedInvocation configures Namedvaluer.Named to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeNamedvaluer) SetNamedInvocation(calls_sym13 []*NamedvaluerNamedInvocation, fallback_sym13 func() bool) {
-	f_sym13.NamedHook = func(a int, b string) (ret bool) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.A, a) && reflect.DeepEqual(call_sym13.Parameters.B, b) {
-				ret = call_sym13.Results.Ret
+func (f_sym15 *FakeNamedvaluer) SetNamedInvocation(calls_sym15 []*NamedvaluerNamedInvocation, fallback_sym15 func() bool) {
+	f_sym15.NamedHook = func(a int, b string) (ret bool) {
+		for _, call_sym15 := range calls_sym15 {
+			if reflect.DeepEqual(call_sym15.Parameters.A, a) && reflect.DeepEqual(call_sym15.Parameters.B, b) {
+				ret = call_sym15.Results.Ret
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback_sym15()
 	}
 }
 
@@ -395,9 +431,9 @@ func (f *FakeNamedvaluer) AssertNamedCalledN(t NamedvaluerTestingT, n int) {
 }
 
 // NamedCalledWith returns true if FakeNamedvaluer.Named was called with the given values
-func (f_sym14 *FakeNamedvaluer) NamedCalledWith(a int, b string) bool {
-	for _, call_sym14 := range f_sym14.NamedCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.A, a) && reflect.DeepEqual(call_sym14.Parameters.B, b) {
+func (f_sym16 *FakeNamedvaluer) NamedCalledWith(a int, b string) bool {
+	for _, call_sym16 := range f_sym16.NamedCalls {
+		if reflect.DeepEqual(call_sym16.Parameters.A, a) && reflect.DeepEqual(call_sym16.Parameters.B, b) {
 			return true
 		}
 	}
@@ -406,54 +442,54 @@ func (f_sym14 *FakeNamedvaluer) NamedCalledWith(a int, b string) bool {
 }
 
 // AssertNamedCalledWith calls t.Error if FakeNamedvaluer.Named was not called with the given values
-func (f_sym15 *FakeNamedvaluer) AssertNamedCalledWith(t NamedvaluerTestingT, a int, b string) {
+func (f_sym17 *FakeNamedvaluer) AssertNamedCalledWith(t NamedvaluerTestingT, a int, b string) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.NamedCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.A, a) && reflect.DeepEqual(call_sym15.Parameters.B, b) {
-			found_sym15 = true
+	var found_sym17 bool
+	for _, call_sym17 := range f_sym17.NamedCalls {
+		if reflect.DeepEqual(call_sym17.Parameters.A, a) && reflect.DeepEqual(call_sym17.Parameters.B, b) {
+			found_sym17 = true
 			break
 		}
 	}
 
-	if !found_sym15 {
+	if !found_sym17 {
 		t.Error("FakeNamedvaluer.Named not called with expected parameters")
 	}
 }
 
 // NamedCalledOnceWith returns true if FakeNamedvaluer.Named was called exactly once with the given values
-func (f_sym16 *FakeNamedvaluer) NamedCalledOnceWith(a int, b string) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.NamedCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.A, a) && reflect.DeepEqual(call_sym16.Parameters.B, b) {
-			count_sym16++
+func (f_sym18 *FakeNamedvaluer) NamedCalledOnceWith(a int, b string) bool {
+	var count_sym18 int
+	for _, call_sym18 := range f_sym18.NamedCalls {
+		if reflect.DeepEqual(call_sym18.Parameters.A, a) && reflect.DeepEqual(call_sym18.Parameters.B, b) {
+			count_sym18++
 		}
 	}
 
-	return count_sym16 == 1
+	return count_sym18 == 1
 }
 
 // AssertNamedCalledOnceWith calls t.Error if FakeNamedvaluer.Named was not called exactly once with the given values
-func (f_sym17 *FakeNamedvaluer) AssertNamedCalledOnceWith(t NamedvaluerTestingT, a int, b string) {
+func (f_sym19 *FakeNamedvaluer) AssertNamedCalledOnceWith(t NamedvaluerTestingT, a int, b string) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.NamedCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.A, a) && reflect.DeepEqual(call_sym17.Parameters.B, b) {
-			count_sym17++
+	var count_sym19 int
+	for _, call_sym19 := range f_sym19.NamedCalls {
+		if reflect.DeepEqual(call_sym19.Parameters.A, a) && reflect.DeepEqual(call_sym19.Parameters.B, b) {
+			count_sym19++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeNamedvaluer.Named called %d times with expected parameters, expected one", count_sym17)
+	if count_sym19 != 1 {
+		t.Errorf("FakeNamedvaluer.Named called %d times with expected parameters, expected one", count_sym19)
 	}
 }
 
 // NamedResultsForCall returns the result values for the first call to FakeNamedvaluer.Named with the given values
-func (f_sym18 *FakeNamedvaluer) NamedResultsForCall(a int, b string) (ret bool, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.NamedCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.A, a) && reflect.DeepEqual(call_sym18.Parameters.B, b) {
-			ret = call_sym18.Results.Ret
-			found_sym18 = true
+func (f_sym20 *FakeNamedvaluer) NamedResultsForCall(a int, b string) (ret bool, found_sym20 bool) {
+	for _, call_sym20 := range f_sym20.NamedCalls {
+		if reflect.DeepEqual(call_sym20.Parameters.A, a) && reflect.DeepEqual(call_sym20.Parameters.B, b) {
+			ret = call_sym20.Results.Ret
+			found_sym20 = true
 			break
 		}
 	}
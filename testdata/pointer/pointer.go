@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/pointer -output=testdata/pointer/pointer.go Pointer".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/pointer -output=testdata/pointer/pointer.go Pointer".  DO NOT EDIT.
 
 package main
 
@@ -49,13 +49,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakePoint ...
+		// assert state of FakePointer ...
 		f.AssertPointCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakePoint.
+unexpected calls are made to FakePointer.
 */
 type FakePointer struct {
 	PointHook func(*string) int
@@ -63,6 +63,8 @@ type FakePointer struct {
 	PointCalls []*PointerPointInvocation
 }
 
+var _ Pointer = (*FakePointer)(nil)
+
 // NewFakePointerDefaultPanic returns an instance of FakePointer with all hooks configured to panic
 func NewFakePointerDefaultPanic() *FakePointer {
 	return &FakePointer{
@@ -92,47 +94,74 @@ func NewFakePointerDefaultError(t_sym2 PointerTestingT) *FakePointer {
 	}
 }
 
+// NewFakePointerDefaultZero returns an instance of FakePointer with all hooks configured to return zero values
+func NewFakePointerDefaultZero() *FakePointer {
+	return &FakePointer{
+		PointHook: func(*string) (ident2 int) {
+			return
+		},
+	}
+}
+
+// NewFakePointerDefaultErr returns an instance of FakePointer with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakePointerDefaultErr(err_sym3 error) *FakePointer {
+	return &FakePointer{
+		PointHook: func(*string) (ident2 int) {
+			return
+		},
+	}
+}
+
+// NewFakePointerSpy returns an instance of FakePointer with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakePointerSpy(real_sym4 Pointer) *FakePointer {
+	return &FakePointer{
+		PointHook: real_sym4.Point,
+	}
+}
+
 func (f *FakePointer) Reset() {
 	f.PointCalls = []*PointerPointInvocation{}
 }
 
-func (f_sym3 *FakePointer) Point(ident1 *string) (ident2 int) {
-	if f_sym3.PointHook == nil {
+func (f_sym5 *FakePointer) Point(ident1 *string) (ident2 int) {
+	if f_sym5.PointHook == nil {
 		panic("Pointer.Point() called but FakePointer.PointHook is nil")
 	}
 
-	invocation_sym3 := new(PointerPointInvocation)
-	f_sym3.PointCalls = append(f_sym3.PointCalls, invocation_sym3)
+	invocation_sym5 := new(PointerPointInvocation)
+	f_sym5.PointCalls = append(f_sym5.PointCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation_sym5.Parameters.Ident1 = ident1
 
-	ident2 = f_sym3.PointHook(ident1)
+	ident2 = f_sym5.PointHook(ident1)
 
-	invocation_sym3.Results.Ident2 = ident2
+	invocation_sym5.Results.Ident2 = ident2
 
 	return
 }
 
 // SetPointStub configures Pointer.Point to always return the given values
-func (f_sym4 *FakePointer) SetPointStub(ident2 int) {
-	f_sym4.PointHook = func(*string) int {
+func (f_sym6 *FakePointer) SetPointStub(ident2 int) {
+	f_sym6.PointHook = func(*string) int {
 		return ident2
 	}
 }
 
 // SetPointInvocation configures Pointer.Point to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakePointer) SetPointInvocation(calls_sym5 []*PointerPointInvocation, fallback_sym5 func() int) {
-	f_sym5.PointHook = func(ident1 *string) (ident2 int) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+func (f_sym7 *FakePointer) SetPointInvocation(calls_sym7 []*PointerPointInvocation, fallback_sym7 func() int) {
+	f_sym7.PointHook = func(ident1 *string) (ident2 int) {
+		for _, call_sym7 := range calls_sym7 {
+			if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
+				ident2 = call_sym7.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback_sym7()
 	}
 }
 
@@ -189,9 +218,9 @@ func (f *FakePointer) AssertPointCalledN(t PointerTestingT, n int) {
 }
 
 // PointCalledWith returns true if FakePointer.Point was called with the given values
-func (f_sym6 *FakePointer) PointCalledWith(ident1 *string) bool {
-	for _, call_sym6 := range f_sym6.PointCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+func (f_sym8 *FakePointer) PointCalledWith(ident1 *string) bool {
+	for _, call_sym8 := range f_sym8.PointCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -200,54 +229,54 @@ func (f_sym6 *FakePointer) PointCalledWith(ident1 *string) bool {
 }
 
 // AssertPointCalledWith calls t.Error if FakePointer.Point was not called with the given values
-func (f_sym7 *FakePointer) AssertPointCalledWith(t PointerTestingT, ident1 *string) {
+func (f_sym9 *FakePointer) AssertPointCalledWith(t PointerTestingT, ident1 *string) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.PointCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	var found_sym9 bool
+	for _, call_sym9 := range f_sym9.PointCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
+			found_sym9 = true
 			break
 		}
 	}
 
-	if !found_sym7 {
+	if !found_sym9 {
 		t.Error("FakePointer.Point not called with expected parameters")
 	}
 }
 
 // PointCalledOnceWith returns true if FakePointer.Point was called exactly once with the given values
-func (f_sym8 *FakePointer) PointCalledOnceWith(ident1 *string) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.PointCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+func (f_sym10 *FakePointer) PointCalledOnceWith(ident1 *string) bool {
+	var count_sym10 int
+	for _, call_sym10 := range f_sym10.PointCalls {
+		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
+			count_sym10++
 		}
 	}
 
-	return count_sym8 == 1
+	return count_sym10 == 1
 }
 
 // AssertPointCalledOnceWith calls t.Error if FakePointer.Point was not called exactly once with the given values
-func (f_sym9 *FakePointer) AssertPointCalledOnceWith(t PointerTestingT, ident1 *string) {
+func (f_sym11 *FakePointer) AssertPointCalledOnceWith(t PointerTestingT, ident1 *string) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.PointCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	var count_sym11 int
+	for _, call_sym11 := range f_sym11.PointCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.Ident1, ident1) {
+			count_sym11++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakePointer.Point called %d times with expected parameters, expected one", count_sym9)
+	if count_sym11 != 1 {
+		t.Errorf("FakePointer.Point called %d times with expected parameters, expected one", count_sym11)
 	}
 }
 
 // PointResultsForCall returns the result values for the first call to FakePointer.Point with the given values
-func (f_sym10 *FakePointer) PointResultsForCall(ident1 *string) (ident2 int, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.PointCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+func (f_sym12 *FakePointer) PointResultsForCall(ident1 *string) (ident2 int, found_sym12 bool) {
+	for _, call_sym12 := range f_sym12.PointCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.Ident1, ident1) {
+			ident2 = call_sym12.Results.Ident2
+			found_sym12 = true
 			break
 		}
 	}
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/qualifier -output=testdata/qualifier/qualifier.go Qualifier".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/qualifier -output=testdata/qualifier/qualifier.go Qualifier".  DO NOT EDIT.
 
 package main
 
@@ -8,20 +8,20 @@ import "fmt"
 // QualifierQualifyInvocation represents a single call of FakeQualifier.Qualify
 type QualifierQualifyInvocation struct {
 	Parameters struct {
-		Ident1 fmt.Scanner
+		Scanner1 fmt.Scanner
 	}
 	Results struct {
-		Ident2 fmt.Scanner
+		Scanner2 fmt.Scanner
 	}
 }
 
 // NewQualifierQualifyInvocation creates a new instance of QualifierQualifyInvocation
-func NewQualifierQualifyInvocation(ident1 fmt.Scanner, ident2 fmt.Scanner) *QualifierQualifyInvocation {
+func NewQualifierQualifyInvocation(scanner1 fmt.Scanner, scanner2 fmt.Scanner) *QualifierQualifyInvocation {
 	invocation := new(QualifierQualifyInvocation)
 
-	invocation.Parameters.Ident1 = ident1
+	invocation.Parameters.Scanner1 = scanner1
 
-	invocation.Results.Ident2 = ident2
+	invocation.Results.Scanner2 = scanner2
 
 	return invocation
 }
@@ -67,7 +67,7 @@ Use it in your tests as in this example:
 
 	func TestWithQualifier(t *testing.T) {
 		f := &main.FakeQualifier{
-			QualifyHook: func(ident1 fmt.Scanner) (ident2 fmt.Scanner) {
+			QualifyHook: func(scanner1 fmt.Scanner) (scanner2 fmt.Scanner) {
 				// ensure parameters meet expectations, signal errors using t, etc
 				return
 			},
@@ -75,13 +75,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeQualify ...
+		// assert state of FakeQualifier ...
 		f.AssertQualifyCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeQualify.
+unexpected calls are made to FakeQualifier.
 */
 type FakeQualifier struct {
 	QualifyHook      func(fmt.Scanner) fmt.Scanner
@@ -91,10 +91,12 @@ type FakeQualifier struct {
 	NamedQualifyCalls []*QualifierNamedQualifyInvocation
 }
 
+var _ Qualifier = (*FakeQualifier)(nil)
+
 // NewFakeQualifierDefaultPanic returns an instance of FakeQualifier with all hooks configured to panic
 func NewFakeQualifierDefaultPanic() *FakeQualifier {
 	return &FakeQualifier{
-		QualifyHook: func(fmt.Scanner) (ident2 fmt.Scanner) {
+		QualifyHook: func(fmt.Scanner) (scanner2 fmt.Scanner) {
 			panic("Unexpected call to Qualifier.Qualify")
 		},
 		NamedQualifyHook: func(fmt.Scanner, fmt.Scanner, fmt.Scanner) (d fmt.Scanner) {
@@ -106,7 +108,7 @@ func NewFakeQualifierDefaultPanic() *FakeQualifier {
 // NewFakeQualifierDefaultFatal returns an instance of FakeQualifier with all hooks configured to call t.Fatal
 func NewFakeQualifierDefaultFatal(t_sym1 QualifierTestingT) *FakeQualifier {
 	return &FakeQualifier{
-		QualifyHook: func(fmt.Scanner) (ident2 fmt.Scanner) {
+		QualifyHook: func(fmt.Scanner) (scanner2 fmt.Scanner) {
 			t_sym1.Fatal("Unexpected call to Qualifier.Qualify")
 			return
 		},
@@ -120,7 +122,7 @@ func NewFakeQualifierDefaultFatal(t_sym1 QualifierTestingT) *FakeQualifier {
 // NewFakeQualifierDefaultError returns an instance of FakeQualifier with all hooks configured to call t.Error
 func NewFakeQualifierDefaultError(t_sym2 QualifierTestingT) *FakeQualifier {
 	return &FakeQualifier{
-		QualifyHook: func(fmt.Scanner) (ident2 fmt.Scanner) {
+		QualifyHook: func(fmt.Scanner) (scanner2 fmt.Scanner) {
 			t_sym2.Error("Unexpected call to Qualifier.Qualify")
 			return
 		},
@@ -131,48 +133,82 @@ func NewFakeQualifierDefaultError(t_sym2 QualifierTestingT) *FakeQualifier {
 	}
 }
 
+// NewFakeQualifierDefaultZero returns an instance of FakeQualifier with all hooks configured to return zero values
+func NewFakeQualifierDefaultZero() *FakeQualifier {
+	return &FakeQualifier{
+		QualifyHook: func(fmt.Scanner) (scanner2 fmt.Scanner) {
+			return
+		},
+		NamedQualifyHook: func(fmt.Scanner, fmt.Scanner, fmt.Scanner) (d fmt.Scanner) {
+			return
+		},
+	}
+}
+
+// NewFakeQualifierDefaultErr returns an instance of FakeQualifier with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeQualifierDefaultErr(err_sym3 error) *FakeQualifier {
+	return &FakeQualifier{
+		QualifyHook: func(fmt.Scanner) (scanner2 fmt.Scanner) {
+			return
+		},
+		NamedQualifyHook: func(fmt.Scanner, fmt.Scanner, fmt.Scanner) (d fmt.Scanner) {
+			return
+		},
+	}
+}
+
+// NewFakeQualifierSpy returns an instance of FakeQualifier with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeQualifierSpy(real_sym4 Qualifier) *FakeQualifier {
+	return &FakeQualifier{
+		QualifyHook:      real_sym4.Qualify,
+		NamedQualifyHook: real_sym4.NamedQualify,
+	}
+}
+
 func (f *FakeQualifier) Reset() {
 	f.QualifyCalls = []*QualifierQualifyInvocation{}
 	f.NamedQualifyCalls = []*QualifierNamedQualifyInvocation{}
 }
 
-func (f_sym3 *FakeQualifier) Qualify(ident1 fmt.Scanner) (ident2 fmt.Scanner) {
-	if f_sym3.QualifyHook == nil {
+func (f_sym5 *FakeQualifier) Qualify(scanner1 fmt.Scanner) (scanner2 fmt.Scanner) {
+	if f_sym5.QualifyHook == nil {
 		panic("Qualifier.Qualify() called but FakeQualifier.QualifyHook is nil")
 	}
 
-	invocation_sym3 := new(QualifierQualifyInvocation)
-	f_sym3.QualifyCalls = append(f_sym3.QualifyCalls, invocation_sym3)
+	invocation_sym5 := new(QualifierQualifyInvocation)
+	f_sym5.QualifyCalls = append(f_sym5.QualifyCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation_sym5.Parameters.Scanner1 = scanner1
 
-	ident2 = f_sym3.QualifyHook(ident1)
+	scanner2 = f_sym5.QualifyHook(scanner1)
 
-	invocation_sym3.Results.Ident2 = ident2
+	invocation_sym5.Results.Scanner2 = scanner2
 
 	return
 }
 
 // SetQualifyStub configures Qualifier.Qualify to always return the given values
-func (f_sym4 *FakeQualifier) SetQualifyStub(ident2 fmt.Scanner) {
-	f_sym4.QualifyHook = func(fmt.Scanner) fmt.Scanner {
-		return ident2
+func (f_sym6 *FakeQualifier) SetQualifyStub(scanner2 fmt.Scanner) {
+	f_sym6.QualifyHook = func(fmt.Scanner) fmt.Scanner {
+		return scanner2
 	}
 }
 
 // SetQualifyInvocation configures Qualifier.Qualify to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeQualifier) SetQualifyInvocation(calls_sym5 []*QualifierQualifyInvocation, fallback_sym5 func() fmt.Scanner) {
-	f_sym5.QualifyHook = func(ident1 fmt.Scanner) (ident2 fmt.Scanner) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+func (f_sym7 *FakeQualifier) SetQualifyInvocation(calls_sym7 []*QualifierQualifyInvocation, fallback_sym7 func() fmt.Scanner) {
+	f_sym7.QualifyHook = func(scanner1 fmt.Scanner) (scanner2 fmt.Scanner) {
+		for _, call_sym7 := range calls_sym7 {
+			if reflect.DeepEqual(call_sym7.Parameters.Scanner1, scanner1) {
+				scanner2 = call_sym7.Results.Scanner2
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback_sym7()
 	}
 }
 
@@ -229,9 +265,9 @@ func (f *FakeQualifier) AssertQualifyCalledN(t QualifierTestingT, n int) {
 }
 
 // QualifyCalledWith returns true if FakeQualifier.Qualify was called with the given values
-func (f_sym6 *FakeQualifier) QualifyCalledWith(ident1 fmt.Scanner) bool {
-	for _, call_sym6 := range f_sym6.QualifyCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+func (f_sym8 *FakeQualifier) QualifyCalledWith(scanner1 fmt.Scanner) bool {
+	for _, call_sym8 := range f_sym8.QualifyCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.Scanner1, scanner1) {
 			return true
 		}
 	}
@@ -240,54 +276,54 @@ func (f_sym6 *FakeQualifier) QualifyCalledWith(ident1 fmt.Scanner) bool {
 }
 
 // AssertQualifyCalledWith calls t.Error if FakeQualifier.Qualify was not called with the given values
-func (f_sym7 *FakeQualifier) AssertQualifyCalledWith(t QualifierTestingT, ident1 fmt.Scanner) {
+func (f_sym9 *FakeQualifier) AssertQualifyCalledWith(t QualifierTestingT, scanner1 fmt.Scanner) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.QualifyCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	var found_sym9 bool
+	for _, call_sym9 := range f_sym9.QualifyCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Scanner1, scanner1) {
+			found_sym9 = true
 			break
 		}
 	}
 
-	if !found_sym7 {
+	if !found_sym9 {
 		t.Error("FakeQualifier.Qualify not called with expected parameters")
 	}
 }
 
 // QualifyCalledOnceWith returns true if FakeQualifier.Qualify was called exactly once with the given values
-func (f_sym8 *FakeQualifier) QualifyCalledOnceWith(ident1 fmt.Scanner) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.QualifyCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+func (f_sym10 *FakeQualifier) QualifyCalledOnceWith(scanner1 fmt.Scanner) bool {
+	var count_sym10 int
+	for _, call_sym10 := range f_sym10.QualifyCalls {
+		if reflect.DeepEqual(call_sym10.Parameters.Scanner1, scanner1) {
+			count_sym10++
 		}
 	}
 
-	return count_sym8 == 1
+	return count_sym10 == 1
 }
 
 // AssertQualifyCalledOnceWith calls t.Error if FakeQualifier.Qualify was not called exactly once with the given values
-func (f_sym9 *FakeQualifier) AssertQualifyCalledOnceWith(t QualifierTestingT, ident1 fmt.Scanner) {
+func (f_sym11 *FakeQualifier) AssertQualifyCalledOnceWith(t QualifierTestingT, scanner1 fmt.Scanner) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.QualifyCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	var count_sym11 int
+	for _, call_sym11 := range f_sym11.QualifyCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.Scanner1, scanner1) {
+			count_sym11++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeQualifier.Qualify called %d times with expected parameters, expected one", count_sym9)
+	if count_sym11 != 1 {
+		t.Errorf("FakeQualifier.Qualify called %d times with expected parameters, expected one", count_sym11)
 	}
 }
 
 // QualifyResultsForCall returns the result values for the first call to FakeQualifier.Qualify with the given values
-func (f_sym10 *FakeQualifier) QualifyResultsForCall(ident1 fmt.Scanner) (ident2 fmt.Scanner, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.QualifyCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+func (f_sym12 *FakeQualifier) QualifyResultsForCall(scanner1 fmt.Scanner) (scanner2 fmt.Scanner, found_sym12 bool) {
+	for _, call_sym12 := range f_sym12.QualifyCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.Scanner1, scanner1) {
+			scanner2 = call_sym12.Results.Scanner2
+			found_sym12 = true
 			break
 		}
 	}
@@ -295,45 +331,45 @@ func (f_sym10 *FakeQualifier) QualifyResultsForCall(ident1 fmt.Scanner) (ident2
 	return
 }
 
-func (f_sym11 *FakeQualifier) NamedQualify(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner) {
-	if f_sym11.NamedQualifyHook == nil {
+func (f_sym13 *FakeQualifier) NamedQualify(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner) {
+	if f_sym13.NamedQualifyHook == nil {
 		panic("Qualifier.NamedQualify() called but FakeQualifier.NamedQualifyHook is nil")
 	}
 
-	invocation_sym11 := new(QualifierNamedQualifyInvocation)
-	f_sym11.NamedQualifyCalls = append(f_sym11.NamedQualifyCalls, invocation_sym11)
+	invocation_sym13 := new(QualifierNamedQualifyInvocation)
+	f_sym13.NamedQualifyCalls = append(f_sym13.NamedQualifyCalls, invocation_sym13)
 
-	invocation_sym11.Parameters.A = a
-	invocation_sym11.Parameters.B = b
-	invocation_sym11.Parameters.C = c
+	invocation_sym13.Parameters.A = a
+	invocation_sym13.Parameters.B = b
+	invocation_sym13.Parameters.C = c
 
-	d = f_sym11.NamedQualifyHook(a, b, c)
+	d = f_sym13.NamedQualifyHook(a, b, c)
 
-	invocation_sym11.Results.D = d
+	invocation_sym13.Results.D = d
 
 	return
 }
 
 // SetNamedQualifyStub configures Qualifier.NamedQualify to always return the given values
-func (f_sym12 *FakeQualifier) SetNamedQualifyStub(d fmt.Scanner) {
-	f_sym12.NamedQualifyHook = func(fmt.Scanner, fmt.Scanner, fmt.Scanner) fmt.Scanner {
+func (f_sym14 *FakeQualifier) SetNamedQualifyStub(d fmt.Scanner) {
+	f_sym14.NamedQualifyHook = func(fmt.Scanner, fmt.Scanner, fmt.Scanner) fmt.Scanner {
 		return d
 	}
 }
 
 // SetNamedQualifyInvocation configures Qualifier.NamedQualify to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeQualifier) SetNamedQualifyInvocation(calls_sym13 []*QualifierNamedQualifyInvocation, fallback_sym13 func() fmt.Scanner) {
-	f_sym13.NamedQualifyHook = func(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.A, a) && reflect.DeepEqual(call_sym13.Parameters.B, b) && reflect.DeepEqual(call_sym13.Parameters.C, c) {
-				d = call_sym13.Results.D
+func (f_sym15 *FakeQualifier) SetNamedQualifyInvocation(calls_sym15 []*QualifierNamedQualifyInvocation, fallback_sym15 func() fmt.Scanner) {
+	f_sym15.NamedQualifyHook = func(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner) {
+		for _, call_sym15 := range calls_sym15 {
+			if reflect.DeepEqual(call_sym15.Parameters.A, a) && reflect.DeepEqual(call_sym15.Parameters.B, b) && reflect.DeepEqual(call_sym15.Parameters.C, c) {
+				d = call_sym15.Results.D
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback_sym15()
 	}
 }
 
@@ -390,9 +426,9 @@ func (f *FakeQualifier) AssertNamedQualifyCalledN(t QualifierTestingT, n int) {
 }
 
 // NamedQualifyCalledWith returns true if FakeQualifier.NamedQualify was called with the given values
-func (f_sym14 *FakeQualifier) NamedQualifyCalledWith(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) bool {
-	for _, call_sym14 := range f_sym14.NamedQualifyCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.A, a) && reflect.DeepEqual(call_sym14.Parameters.B, b) && reflect.DeepEqual(call_sym14.Parameters.C, c) {
+func (f_sym16 *FakeQualifier) NamedQualifyCalledWith(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) bool {
+	for _, call_sym16 := range f_sym16.NamedQualifyCalls {
+		if reflect.DeepEqual(call_sym16.Parameters.A, a) && reflect.DeepEqual(call_sym16.Parameters.B, b) && reflect.DeepEqual(call_sym16.Parameters.C, c) {
 			return true
 		}
 	}
@@ -401,54 +437,54 @@ func (f_sym14 *FakeQualifier) NamedQualifyCalledWith(a fmt.Scanner, b fmt.Scanne
 }
 
 // AssertNamedQualifyCalledWith calls t.Error if FakeQualifier.NamedQualify was not called with the given values
-func (f_sym15 *FakeQualifier) AssertNamedQualifyCalledWith(t QualifierTestingT, a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) {
+func (f_sym17 *FakeQualifier) AssertNamedQualifyCalledWith(t QualifierTestingT, a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.NamedQualifyCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.A, a) && reflect.DeepEqual(call_sym15.Parameters.B, b) && reflect.DeepEqual(call_sym15.Parameters.C, c) {
-			found_sym15 = true
+	var found_sym17 bool
+	for _, call_sym17 := range f_sym17.NamedQualifyCalls {
+		if reflect.DeepEqual(call_sym17.Parameters.A, a) && reflect.DeepEqual(call_sym17.Parameters.B, b) && reflect.DeepEqual(call_sym17.Parameters.C, c) {
+			found_sym17 = true
 			break
 		}
 	}
 
-	if !found_sym15 {
+	if !found_sym17 {
 		t.Error("FakeQualifier.NamedQualify not called with expected parameters")
 	}
 }
 
 // NamedQualifyCalledOnceWith returns true if FakeQualifier.NamedQualify was called exactly once with the given values
-func (f_sym16 *FakeQualifier) NamedQualifyCalledOnceWith(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.NamedQualifyCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.A, a) && reflect.DeepEqual(call_sym16.Parameters.B, b) && reflect.DeepEqual(call_sym16.Parameters.C, c) {
-			count_sym16++
+func (f_sym18 *FakeQualifier) NamedQualifyCalledOnceWith(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) bool {
+	var count_sym18 int
+	for _, call_sym18 := range f_sym18.NamedQualifyCalls {
+		if reflect.DeepEqual(call_sym18.Parameters.A, a) && reflect.DeepEqual(call_sym18.Parameters.B, b) && reflect.DeepEqual(call_sym18.Parameters.C, c) {
+			count_sym18++
 		}
 	}
 
-	return count_sym16 == 1
+	return count_sym18 == 1
 }
 
 // AssertNamedQualifyCalledOnceWith calls t.Error if FakeQualifier.NamedQualify was not called exactly once with the given values
-func (f_sym17 *FakeQualifier) AssertNamedQualifyCalledOnceWith(t QualifierTestingT, a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) {
+func (f_sym19 *FakeQualifier) AssertNamedQualifyCalledOnceWith(t QualifierTestingT, a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.NamedQualifyCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.A, a) && reflect.DeepEqual(call_sym17.Parameters.B, b) && reflect.DeepEqual(call_sym17.Parameters.C, c) {
-			count_sym17++
+	var count_sym19 int
+	for _, call_sym19 := range f_sym19.NamedQualifyCalls {
+		if reflect.DeepEqual(call_sym19.Parameters.A, a) && reflect.DeepEqual(call_sym19.Parameters.B, b) && reflect.DeepEqual(call_sym19.Parameters.C, c) {
+			count_sym19++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeQualifier.NamedQualify called %d times with expected parameters, expected one", count_sym17)
+	if count_sym19 != 1 {
+		t.Errorf("FakeQualifier.NamedQualify called %d times with expected parameters, expected one", count_sym19)
 	}
 }
 
 // NamedQualifyResultsForCall returns the result values for the first call to FakeQualifier.NamedQualify with the given values
-func (f_sym18 *FakeQualifier) NamedQualifyResultsForCall(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.NamedQualifyCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.A, a) && reflect.DeepEqual(call_sym18.Parameters.B, b) && reflect.DeepEqual(call_sym18.Parameters.C, c) {
-			d = call_sym18.Results.D
-			found_sym18 = true
+func (f_sym20 *FakeQualifier) NamedQualifyResultsForCall(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner, found_sym20 bool) {
+	for _, call_sym20 := range f_sym20.NamedQualifyCalls {
+		if reflect.DeepEqual(call_sym20.Parameters.A, a) && reflect.DeepEqual(call_sym20.Parameters.B, b) && reflect.DeepEqual(call_sym20.Parameters.C, c) {
+			d = call_sym20.Results.D
+			found_sym20 = true
 			break
 		}
 	}
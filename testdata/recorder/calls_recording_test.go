@@ -0,0 +1,38 @@
+// Calls recorded in calls.json, generated by "charlatan -dir=testdata/recorder -features=all -from-recording=testdata/recorder/calls.json -output=testdata/recorder/calls_recording_test.go Recorder".  Edit as needed.
+
+package main
+
+import "errors"
+import "time"
+
+// SetFakeRecorderRecording configures f to return the results of the calls of Recorder recorded in calls.json
+func SetFakeRecorderRecording(f *FakeRecorder) {
+	f.SetLookupInvocationMatch(CharlatanAny(), CharlatanEq("k1"), CharlatanEq(time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)), &Item{Name: "k1", Count: 2, Tags: []string{"a", "b"}}, true, nil)
+	f.SetLookupInvocationMatch(CharlatanAny(), CharlatanEq("missing"), CharlatanEq(time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)), nil, false, errors.New("not found"))
+	f.SetPutInvocation([]*RecorderPutInvocation{
+		NewRecorderPutInvocation(map[string]Item{"x": Item{Name: "x", Count: 1}}, []byte("raw\n"), nil),
+	}, func() error {
+		panic("Recorder.Put() called with parameters not recorded in calls.json")
+	})
+	f.SetFlushStubSequence(
+		RecorderFlushResults{N: 3, Err: nil},
+		RecorderFlushResults{N: 0, Err: errors.New("closed")},
+	)
+	f.SetConvertInvocation([]*RecorderConvertInvocation{
+		NewRecorderConvertInvocation([]interface{}{float64(1.5), "s", true, nil}, map[string]interface{}{"v": []interface{}{float64(1.5), "s", true, nil}}),
+	}, func() interface{} {
+		panic("Recorder.Convert() called with parameters not recorded in calls.json")
+	})
+	f.CloseHook = func() {}
+}
+
+// AssertFakeRecorderRecording calls t.Error if the calls of Recorder recorded in calls.json were not made to f
+func AssertFakeRecorderRecording(t RecorderTestingT, f *FakeRecorder) {
+	t.Helper()
+	f.AssertLookupCalledWithMatch(t, CharlatanAny(), CharlatanEq("k1"), CharlatanEq(time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)))
+	f.AssertLookupCalledWithMatch(t, CharlatanAny(), CharlatanEq("missing"), CharlatanEq(time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)))
+	f.AssertPutCalledWith(t, map[string]Item{"x": Item{Name: "x", Count: 1}}, []byte("raw\n"))
+	f.AssertFlushCalledN(t, 2)
+	f.AssertConvertCalledWith(t, []interface{}{float64(1.5), "s", true, nil})
+	f.AssertCloseCalledN(t, 1)
+}
@@ -0,0 +1,2687 @@
+// Code generated by "charlatan -dir=testdata/recorder -features=all -output=testdata/recorder/recorder.go Recorder".  DO NOT EDIT.
+
+package main
+
+import "reflect"
+import "context"
+import "time"
+import "sync"
+import "sort"
+import "strings"
+import "sync/atomic"
+import "encoding/json"
+import "os"
+import "errors"
+
+// CharlatanMatcher matches a parameter of a call to a charlatan Fake, see CharlatanAny, CharlatanEq, CharlatanNot,
+// CharlatanFunc and CharlatanFieldsEq
+type CharlatanMatcher interface {
+	Match(value interface{}) bool
+}
+
+// CharlatanMatcherFunc is a CharlatanMatcher implemented by a predicate
+type CharlatanMatcherFunc func(value interface{}) bool
+
+// Match returns the result of the predicate for the value
+func (m CharlatanMatcherFunc) Match(value interface{}) bool {
+	return m(value)
+}
+
+// CharlatanAny returns a CharlatanMatcher of any value
+func CharlatanAny() CharlatanMatcher {
+	return CharlatanMatcherFunc(func(interface{}) bool {
+		return true
+	})
+}
+
+// CharlatanEq returns a CharlatanMatcher of values deeply equal to expected.  If expected is a CharlatanMatcher it is
+// returned as is.
+func CharlatanEq(expected interface{}) CharlatanMatcher {
+	if m, ok := expected.(CharlatanMatcher); ok {
+		return m
+	}
+
+	return CharlatanMatcherFunc(func(value interface{}) bool {
+		return reflect.DeepEqual(expected, value)
+	})
+}
+
+// CharlatanNot returns a CharlatanMatcher of the values not matched by m
+func CharlatanNot(m CharlatanMatcher) CharlatanMatcher {
+	return CharlatanMatcherFunc(func(value interface{}) bool {
+		return !m.Match(value)
+	})
+}
+
+// CharlatanFunc returns a CharlatanMatcher of the values for which the predicate returns true
+func CharlatanFunc(predicate func(value interface{}) bool) CharlatanMatcher {
+	return CharlatanMatcherFunc(predicate)
+}
+
+// CharlatanFieldsEq returns a CharlatanMatcher of structs, or pointers to structs, with the named fields equal to the
+// given values, e.g. CharlatanFieldsEq("UserID", 42, "Name", CharlatanNot(CharlatanEq(""))).  The arguments alternate
+// field names and values, which are compared as by CharlatanEq.
+func CharlatanFieldsEq(namesAndValues ...interface{}) CharlatanMatcher {
+	if len(namesAndValues)%2 != 0 {
+		panic("CharlatanFieldsEq called with a field name and no value")
+	}
+
+	return CharlatanMatcherFunc(func(value interface{}) bool {
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return false
+		}
+
+		for i := 0; i < len(namesAndValues); i += 2 {
+			name, _ := namesAndValues[i].(string)
+			field := v.FieldByName(name)
+			if !field.IsValid() || !field.CanInterface() || !CharlatanEq(namesAndValues[i+1]).Match(field.Interface()) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// CharlatanExhausted is called by the stub sequences of the charlatan Fakes for each call made once all their results
+// have been returned, with the name of the method and the number of results.  See CharlatanRepeatLast,
+// CharlatanPanicWhenExhausted and CharlatanFailWhenExhausted.
+type CharlatanExhausted func(method string, results int)
+
+// CharlatanRepeatLast returns a CharlatanExhausted that lets a stub sequence return its last results again
+func CharlatanRepeatLast() CharlatanExhausted {
+	return func(string, int) {}
+}
+
+// CharlatanPanicWhenExhausted returns a CharlatanExhausted that panics when a stub sequence is called after its last
+// results
+func CharlatanPanicWhenExhausted() CharlatanExhausted {
+	return func(method string, results int) {
+		panic(method + "() called after the last results of its stub sequence")
+	}
+}
+
+// CharlatanFailWhenExhausted returns a CharlatanExhausted that calls t.Errorf when a stub sequence is called after its
+// last results
+func CharlatanFailWhenExhausted(t interface {
+	Errorf(string, ...interface{})
+}) CharlatanExhausted {
+	return func(method string, results int) {
+		t.Errorf("%s() called more than %d times, the length of its stub sequence", method, results)
+	}
+}
+
+// CharlatanCall is a call recorded by a charlatan Fake, see CharlatanInOrder
+type CharlatanCall interface {
+	// CallName returns the name of the method called, e.g. "FakeTx.Begin"
+	CallName() string
+	// CallSequence returns the position of the call among the calls of all the fakes in the package
+	CallSequence() uint64
+	// N.B. - the sequences of the fakes of other packages are counted separately, their calls are not comparable
+	charlatanCall()
+}
+
+// CharlatanInOrder calls t.Error if the calls, of any fakes in the package, were not made in the given order.  The
+// error reports the order the calls were made in.
+func CharlatanInOrder(t interface {
+	Helper()
+	Errorf(string, ...interface{})
+}, calls ...CharlatanCall) {
+	t.Helper()
+	for i := 1; i < len(calls); i++ {
+		if calls[i].CallSequence() <= calls[i-1].CallSequence() {
+			t.Errorf("calls not made in the order %s, actual order: %s", charlatanCallNames(calls), charlatanCallNames(charlatanSortCalls(calls)))
+			return
+		}
+	}
+}
+
+// charlatanCallSequence is the position of the last call among the calls of all the fakes in the package
+var charlatanCallSequence uint64
+
+// charlatanNextCall returns the position of a new call
+func charlatanNextCall() uint64 {
+	return atomic.AddUint64(&charlatanCallSequence, 1)
+}
+
+// charlatanSortCalls returns a copy of the calls sorted in the order they were made
+func charlatanSortCalls(calls []CharlatanCall) []CharlatanCall {
+	sorted := append([]CharlatanCall(nil), calls...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].CallSequence() < sorted[j].CallSequence()
+	})
+
+	return sorted
+}
+
+// charlatanCallNames returns the names of the calls separated by commas
+func charlatanCallNames(calls []CharlatanCall) string {
+	names := make([]string, len(calls))
+	for i, call := range calls {
+		names[i] = call.CallName()
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// charlatanErrorMessage returns the message of an error recorded in a cassette, or nil
+func charlatanErrorMessage(err error) *string {
+	if err == nil {
+		return nil
+	}
+	message := err.Error()
+
+	return &message
+}
+
+// charlatanError returns an error with the message recorded in a cassette, or nil
+func charlatanError(message *string) error {
+	if message == nil {
+		return nil
+	}
+
+	return errors.New(*message)
+}
+
+// RecorderLookupInvocation represents a single call of FakeRecorder.Lookup
+type RecorderLookupInvocation struct {
+	Parameters struct {
+		Ctx context.Context
+		Key string
+		At  time.Time
+	}
+	Results struct {
+		Item  *Item
+		Found bool
+		Err   error
+	}
+	Sequence uint64 // the position of the call among the calls of all the fakes in the package
+}
+
+// CallName returns the name of the method called, "FakeRecorder.Lookup"
+func (i *RecorderLookupInvocation) CallName() string {
+	return "FakeRecorder.Lookup"
+}
+
+// CallSequence returns the position of the call among the calls of all the fakes in the package
+func (i *RecorderLookupInvocation) CallSequence() uint64 {
+	return i.Sequence
+}
+
+func (i *RecorderLookupInvocation) charlatanCall() {}
+
+// charlatanRecorderLookupInvocationJSON is the encoding of RecorderLookupInvocation in cassettes
+type charlatanRecorderLookupInvocationJSON struct {
+	Interface  string `json:"interface"`
+	Method     string `json:"method"`
+	Parameters struct {
+		Key string    `json:"key"`
+		At  time.Time `json:"at"`
+	} `json:"parameters"`
+	Results struct {
+		Item  *Item   `json:"item"`
+		Found bool    `json:"found"`
+		Err   *string `json:"err"`
+	} `json:"results"`
+}
+
+// charlatanCassette returns the encoding of the call in cassettes
+func (i *RecorderLookupInvocation) charlatanCassette() *charlatanRecorderLookupInvocationJSON {
+	entry := &charlatanRecorderLookupInvocationJSON{Interface: "Recorder", Method: "Lookup"}
+	entry.Parameters.Key = i.Parameters.Key
+	entry.Parameters.At = i.Parameters.At
+	entry.Results.Item = i.Results.Item
+	entry.Results.Found = i.Results.Found
+	entry.Results.Err = charlatanErrorMessage(i.Results.Err)
+
+	return entry
+}
+
+// charlatanCassetteKey returns the encoded parameters of the call, which identify it in a cassette
+func (i *RecorderLookupInvocation) charlatanCassetteKey() (string, error) {
+	data, err := json.Marshal(i.charlatanCassette().Parameters)
+
+	return string(data), err
+}
+
+// MarshalJSON encodes the call as an entry of a cassette.  Parameters and results that cannot be encoded, such as
+// contexts, funcs and channels, are omitted, and errors are encoded as their messages.
+func (i RecorderLookupInvocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.charlatanCassette())
+}
+
+// UnmarshalJSON decodes the call from an entry of a cassette
+func (i *RecorderLookupInvocation) UnmarshalJSON(data []byte) error {
+	var entry charlatanRecorderLookupInvocationJSON
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+
+	i.Parameters.Key = entry.Parameters.Key
+	i.Parameters.At = entry.Parameters.At
+	i.Results.Item = entry.Results.Item
+	i.Results.Found = entry.Results.Found
+	i.Results.Err = charlatanError(entry.Results.Err)
+
+	return nil
+}
+
+// NewRecorderLookupInvocation creates a new instance of RecorderLookupInvocation
+func NewRecorderLookupInvocation(ctx context.Context, key string, at time.Time, item *Item, found bool, err error) *RecorderLookupInvocation {
+	invocation := new(RecorderLookupInvocation)
+
+	invocation.Parameters.Ctx = ctx
+	invocation.Parameters.Key = key
+	invocation.Parameters.At = at
+
+	invocation.Results.Item = item
+	invocation.Results.Found = found
+	invocation.Results.Err = err
+
+	return invocation
+}
+
+// RecorderPutInvocation represents a single call of FakeRecorder.Put
+type RecorderPutInvocation struct {
+	Parameters struct {
+		Items map[string]Item
+		Data  []byte
+	}
+	Results struct {
+		Err error
+	}
+	Sequence uint64 // the position of the call among the calls of all the fakes in the package
+}
+
+// CallName returns the name of the method called, "FakeRecorder.Put"
+func (i *RecorderPutInvocation) CallName() string {
+	return "FakeRecorder.Put"
+}
+
+// CallSequence returns the position of the call among the calls of all the fakes in the package
+func (i *RecorderPutInvocation) CallSequence() uint64 {
+	return i.Sequence
+}
+
+func (i *RecorderPutInvocation) charlatanCall() {}
+
+// charlatanRecorderPutInvocationJSON is the encoding of RecorderPutInvocation in cassettes
+type charlatanRecorderPutInvocationJSON struct {
+	Interface  string `json:"interface"`
+	Method     string `json:"method"`
+	Parameters struct {
+		Items map[string]Item `json:"items"`
+		Data  []byte          `json:"data"`
+	} `json:"parameters"`
+	Results struct {
+		Err *string `json:"err"`
+	} `json:"results"`
+}
+
+// charlatanCassette returns the encoding of the call in cassettes
+func (i *RecorderPutInvocation) charlatanCassette() *charlatanRecorderPutInvocationJSON {
+	entry := &charlatanRecorderPutInvocationJSON{Interface: "Recorder", Method: "Put"}
+	entry.Parameters.Items = i.Parameters.Items
+	entry.Parameters.Data = i.Parameters.Data
+	entry.Results.Err = charlatanErrorMessage(i.Results.Err)
+
+	return entry
+}
+
+// charlatanCassetteKey returns the encoded parameters of the call, which identify it in a cassette
+func (i *RecorderPutInvocation) charlatanCassetteKey() (string, error) {
+	data, err := json.Marshal(i.charlatanCassette().Parameters)
+
+	return string(data), err
+}
+
+// MarshalJSON encodes the call as an entry of a cassette.  Parameters and results that cannot be encoded, such as
+// contexts, funcs and channels, are omitted, and errors are encoded as their messages.
+func (i RecorderPutInvocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.charlatanCassette())
+}
+
+// UnmarshalJSON decodes the call from an entry of a cassette
+func (i *RecorderPutInvocation) UnmarshalJSON(data []byte) error {
+	var entry charlatanRecorderPutInvocationJSON
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+
+	i.Parameters.Items = entry.Parameters.Items
+	i.Parameters.Data = entry.Parameters.Data
+	i.Results.Err = charlatanError(entry.Results.Err)
+
+	return nil
+}
+
+// NewRecorderPutInvocation creates a new instance of RecorderPutInvocation
+func NewRecorderPutInvocation(items map[string]Item, data []byte, err error) *RecorderPutInvocation {
+	invocation := new(RecorderPutInvocation)
+
+	invocation.Parameters.Items = items
+	invocation.Parameters.Data = data
+
+	invocation.Results.Err = err
+
+	return invocation
+}
+
+// RecorderFlushInvocation represents a single call of FakeRecorder.Flush
+type RecorderFlushInvocation struct {
+	Results struct {
+		N   int
+		Err error
+	}
+	Sequence uint64 // the position of the call among the calls of all the fakes in the package
+}
+
+// CallName returns the name of the method called, "FakeRecorder.Flush"
+func (i *RecorderFlushInvocation) CallName() string {
+	return "FakeRecorder.Flush"
+}
+
+// CallSequence returns the position of the call among the calls of all the fakes in the package
+func (i *RecorderFlushInvocation) CallSequence() uint64 {
+	return i.Sequence
+}
+
+func (i *RecorderFlushInvocation) charlatanCall() {}
+
+// charlatanRecorderFlushInvocationJSON is the encoding of RecorderFlushInvocation in cassettes
+type charlatanRecorderFlushInvocationJSON struct {
+	Interface string `json:"interface"`
+	Method    string `json:"method"`
+	Results   struct {
+		N   int     `json:"n"`
+		Err *string `json:"err"`
+	} `json:"results"`
+}
+
+// charlatanCassette returns the encoding of the call in cassettes
+func (i *RecorderFlushInvocation) charlatanCassette() *charlatanRecorderFlushInvocationJSON {
+	entry := &charlatanRecorderFlushInvocationJSON{Interface: "Recorder", Method: "Flush"}
+	entry.Results.N = i.Results.N
+	entry.Results.Err = charlatanErrorMessage(i.Results.Err)
+
+	return entry
+}
+
+// charlatanCassetteKey returns the encoded parameters of the call, which identify it in a cassette
+func (i *RecorderFlushInvocation) charlatanCassetteKey() (string, error) {
+	return "", nil
+}
+
+// MarshalJSON encodes the call as an entry of a cassette.  Parameters and results that cannot be encoded, such as
+// contexts, funcs and channels, are omitted, and errors are encoded as their messages.
+func (i RecorderFlushInvocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.charlatanCassette())
+}
+
+// UnmarshalJSON decodes the call from an entry of a cassette
+func (i *RecorderFlushInvocation) UnmarshalJSON(data []byte) error {
+	var entry charlatanRecorderFlushInvocationJSON
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+
+	i.Results.N = entry.Results.N
+	i.Results.Err = charlatanError(entry.Results.Err)
+
+	return nil
+}
+
+// RecorderConvertInvocation represents a single call of FakeRecorder.Convert
+type RecorderConvertInvocation struct {
+	Parameters struct {
+		Value interface{}
+	}
+	Results struct {
+		Ident1 interface{}
+	}
+	Sequence uint64 // the position of the call among the calls of all the fakes in the package
+}
+
+// CallName returns the name of the method called, "FakeRecorder.Convert"
+func (i *RecorderConvertInvocation) CallName() string {
+	return "FakeRecorder.Convert"
+}
+
+// CallSequence returns the position of the call among the calls of all the fakes in the package
+func (i *RecorderConvertInvocation) CallSequence() uint64 {
+	return i.Sequence
+}
+
+func (i *RecorderConvertInvocation) charlatanCall() {}
+
+// charlatanRecorderConvertInvocationJSON is the encoding of RecorderConvertInvocation in cassettes
+type charlatanRecorderConvertInvocationJSON struct {
+	Interface  string `json:"interface"`
+	Method     string `json:"method"`
+	Parameters struct {
+		Value interface{} `json:"value"`
+	} `json:"parameters"`
+	Results struct {
+		Ident1 interface{} `json:"ident1"`
+	} `json:"results"`
+}
+
+// charlatanCassette returns the encoding of the call in cassettes
+func (i *RecorderConvertInvocation) charlatanCassette() *charlatanRecorderConvertInvocationJSON {
+	entry := &charlatanRecorderConvertInvocationJSON{Interface: "Recorder", Method: "Convert"}
+	entry.Parameters.Value = i.Parameters.Value
+	entry.Results.Ident1 = i.Results.Ident1
+
+	return entry
+}
+
+// charlatanCassetteKey returns the encoded parameters of the call, which identify it in a cassette
+func (i *RecorderConvertInvocation) charlatanCassetteKey() (string, error) {
+	data, err := json.Marshal(i.charlatanCassette().Parameters)
+
+	return string(data), err
+}
+
+// MarshalJSON encodes the call as an entry of a cassette.  Parameters and results that cannot be encoded, such as
+// contexts, funcs and channels, are omitted, and errors are encoded as their messages.
+func (i RecorderConvertInvocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.charlatanCassette())
+}
+
+// UnmarshalJSON decodes the call from an entry of a cassette
+func (i *RecorderConvertInvocation) UnmarshalJSON(data []byte) error {
+	var entry charlatanRecorderConvertInvocationJSON
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+
+	i.Parameters.Value = entry.Parameters.Value
+	i.Results.Ident1 = entry.Results.Ident1
+
+	return nil
+}
+
+// NewRecorderConvertInvocation creates a new instance of RecorderConvertInvocation
+func NewRecorderConvertInvocation(value interface{}, ident1 interface{}) *RecorderConvertInvocation {
+	invocation := new(RecorderConvertInvocation)
+
+	invocation.Parameters.Value = value
+
+	invocation.Results.Ident1 = ident1
+
+	return invocation
+}
+
+// RecorderCloseInvocation represents a single call of FakeRecorder.Close
+type RecorderCloseInvocation struct {
+	Sequence uint64 // the position of the call among the calls of all the fakes in the package
+}
+
+// CallName returns the name of the method called, "FakeRecorder.Close"
+func (i *RecorderCloseInvocation) CallName() string {
+	return "FakeRecorder.Close"
+}
+
+// CallSequence returns the position of the call among the calls of all the fakes in the package
+func (i *RecorderCloseInvocation) CallSequence() uint64 {
+	return i.Sequence
+}
+
+func (i *RecorderCloseInvocation) charlatanCall() {}
+
+// charlatanRecorderCloseInvocationJSON is the encoding of RecorderCloseInvocation in cassettes
+type charlatanRecorderCloseInvocationJSON struct {
+	Interface string `json:"interface"`
+	Method    string `json:"method"`
+}
+
+// charlatanCassette returns the encoding of the call in cassettes
+func (i *RecorderCloseInvocation) charlatanCassette() *charlatanRecorderCloseInvocationJSON {
+	entry := &charlatanRecorderCloseInvocationJSON{Interface: "Recorder", Method: "Close"}
+
+	return entry
+}
+
+// charlatanCassetteKey returns the encoded parameters of the call, which identify it in a cassette
+func (i *RecorderCloseInvocation) charlatanCassetteKey() (string, error) {
+	return "", nil
+}
+
+// MarshalJSON encodes the call as an entry of a cassette.  Parameters and results that cannot be encoded, such as
+// contexts, funcs and channels, are omitted, and errors are encoded as their messages.
+func (i RecorderCloseInvocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.charlatanCassette())
+}
+
+// UnmarshalJSON decodes the call from an entry of a cassette
+func (i *RecorderCloseInvocation) UnmarshalJSON(data []byte) error {
+	var entry charlatanRecorderCloseInvocationJSON
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecorderTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
+type RecorderTestingT interface {
+	Error(...interface{})
+	Errorf(string, ...interface{})
+	Fatal(...interface{})
+	Helper()
+}
+
+/*
+FakeRecorder is a mock implementation of Recorder for testing.
+Use it in your tests as in this example:
+
+	package example
+
+	func TestWithRecorder(t *testing.T) {
+		f := &main.FakeRecorder{
+			LookupHook: func(ctx context.Context, key string, at time.Time) (item *Item, found bool, err error) {
+				// ensure parameters meet expectations, signal errors using t, etc
+				return
+			},
+		}
+
+		// test code goes here ...
+
+		// assert state of FakeRecorder ...
+		f.AssertLookupCalledOnce(t)
+	}
+
+Create anonymous function implementations for only those interface methods that
+should be called in the code under test.  This will force a panic if any
+unexpected calls are made to FakeRecorder.
+*/
+type FakeRecorder struct {
+	LookupHook  func(context.Context, string, time.Time) (*Item, bool, error)
+	PutHook     func(map[string]Item, []byte) error
+	FlushHook   func() (int, error)
+	ConvertHook func(interface{}) interface{}
+	CloseHook   func()
+
+	LookupCalls  []*RecorderLookupInvocation
+	PutCalls     []*RecorderPutInvocation
+	FlushCalls   []*RecorderFlushInvocation
+	ConvertCalls []*RecorderConvertInvocation
+	CloseCalls   []*RecorderCloseInvocation
+
+	charlatanLookupCount   int                            // the number of calls of Lookup, see SetLookupStubOnCall
+	charlatanLookupOnCall  map[int]RecorderLookupResults  // the results of Lookup configured for a call number
+	charlatanPutCount      int                            // the number of calls of Put, see SetPutStubOnCall
+	charlatanPutOnCall     map[int]RecorderPutResults     // the results of Put configured for a call number
+	charlatanFlushCount    int                            // the number of calls of Flush, see SetFlushStubOnCall
+	charlatanFlushOnCall   map[int]RecorderFlushResults   // the results of Flush configured for a call number
+	charlatanConvertCount  int                            // the number of calls of Convert, see SetConvertStubOnCall
+	charlatanConvertOnCall map[int]RecorderConvertResults // the results of Convert configured for a call number
+
+	strict           bool                                                 // true if the expectations are verified when the test ends
+	expectations     []func(errorf func(string, ...interface{}))          // report the unmet expectations of a strict fake
+	hookExpectations map[string]func(errorf func(string, ...interface{})) // report the unmet expectations of the hooks configured by the Set* methods, by method name
+
+	mutex sync.RWMutex // guards the hooks and calls when the fake is shared by goroutines
+}
+
+var _ Recorder = (*FakeRecorder)(nil)
+
+// NewFakeRecorderDefaultPanic returns an instance of FakeRecorder with all hooks configured to panic
+func NewFakeRecorderDefaultPanic() *FakeRecorder {
+	return &FakeRecorder{
+		LookupHook: func(context.Context, string, time.Time) (item *Item, found bool, err error) {
+			panic("Unexpected call to Recorder.Lookup")
+		},
+		PutHook: func(map[string]Item, []byte) (err error) {
+			panic("Unexpected call to Recorder.Put")
+		},
+		FlushHook: func() (n int, err error) {
+			panic("Unexpected call to Recorder.Flush")
+		},
+		ConvertHook: func(interface{}) (ident1 interface{}) {
+			panic("Unexpected call to Recorder.Convert")
+		},
+		CloseHook: func() {
+			panic("Unexpected call to Recorder.Close")
+		},
+	}
+}
+
+// NewFakeRecorderDefaultFatal returns an instance of FakeRecorder with all hooks configured to call t.Fatal
+func NewFakeRecorderDefaultFatal(t_sym1 RecorderTestingT) *FakeRecorder {
+	return &FakeRecorder{
+		LookupHook: func(context.Context, string, time.Time) (item *Item, found bool, err error) {
+			t_sym1.Fatal("Unexpected call to Recorder.Lookup")
+			return
+		},
+		PutHook: func(map[string]Item, []byte) (err error) {
+			t_sym1.Fatal("Unexpected call to Recorder.Put")
+			return
+		},
+		FlushHook: func() (n int, err error) {
+			t_sym1.Fatal("Unexpected call to Recorder.Flush")
+			return
+		},
+		ConvertHook: func(interface{}) (ident1 interface{}) {
+			t_sym1.Fatal("Unexpected call to Recorder.Convert")
+			return
+		},
+		CloseHook: func() {
+			t_sym1.Fatal("Unexpected call to Recorder.Close")
+			return
+		},
+	}
+}
+
+// NewFakeRecorderDefaultError returns an instance of FakeRecorder with all hooks configured to call t.Error
+func NewFakeRecorderDefaultError(t_sym2 RecorderTestingT) *FakeRecorder {
+	return &FakeRecorder{
+		LookupHook: func(context.Context, string, time.Time) (item *Item, found bool, err error) {
+			t_sym2.Error("Unexpected call to Recorder.Lookup")
+			return
+		},
+		PutHook: func(map[string]Item, []byte) (err error) {
+			t_sym2.Error("Unexpected call to Recorder.Put")
+			return
+		},
+		FlushHook: func() (n int, err error) {
+			t_sym2.Error("Unexpected call to Recorder.Flush")
+			return
+		},
+		ConvertHook: func(interface{}) (ident1 interface{}) {
+			t_sym2.Error("Unexpected call to Recorder.Convert")
+			return
+		},
+		CloseHook: func() {
+			t_sym2.Error("Unexpected call to Recorder.Close")
+			return
+		},
+	}
+}
+
+// NewFakeRecorderDefaultZero returns an instance of FakeRecorder with all hooks configured to return zero values
+func NewFakeRecorderDefaultZero() *FakeRecorder {
+	return &FakeRecorder{
+		LookupHook: func(context.Context, string, time.Time) (item *Item, found bool, err error) {
+			return
+		},
+		PutHook: func(map[string]Item, []byte) (err error) {
+			return
+		},
+		FlushHook: func() (n int, err error) {
+			return
+		},
+		ConvertHook: func(interface{}) (ident1 interface{}) {
+			return
+		},
+		CloseHook: func() {
+			return
+		},
+	}
+}
+
+// NewFakeRecorderDefaultErr returns an instance of FakeRecorder with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeRecorderDefaultErr(err_sym3 error) *FakeRecorder {
+	return &FakeRecorder{
+		LookupHook: func(context.Context, string, time.Time) (item *Item, found bool, err error) {
+			return nil, false, err_sym3
+		},
+		PutHook: func(map[string]Item, []byte) (err error) {
+			return err_sym3
+		},
+		FlushHook: func() (n int, err error) {
+			return 0, err_sym3
+		},
+		ConvertHook: func(interface{}) (ident1 interface{}) {
+			return
+		},
+		CloseHook: func() {
+			return
+		},
+	}
+}
+
+// NewFakeRecorderSpy returns an instance of FakeRecorder with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeRecorderSpy(real_sym4 Recorder) *FakeRecorder {
+	return &FakeRecorder{
+		LookupHook:  real_sym4.Lookup,
+		PutHook:     real_sym4.Put,
+		FlushHook:   real_sym4.Flush,
+		ConvertHook: real_sym4.Convert,
+		CloseHook:   real_sym4.Close,
+	}
+}
+
+// NewFakeRecorderStrict returns an instance of FakeRecorder that fails the test when it ends if a method was
+// called without a configured hook, or if a stub or invocation configured on the fake was never used
+func NewFakeRecorderStrict(t_sym5 interface {
+	RecorderTestingT
+	Cleanup(func())
+}) *FakeRecorder {
+	f_sym5 := &FakeRecorder{strict: true}
+
+	var unexpected_sym6 int
+	f_sym5.LookupHook = func(context.Context, string, time.Time) (item *Item, found bool, err error) {
+		f_sym5.mutex.Lock()
+		unexpected_sym6++
+		f_sym5.mutex.Unlock()
+		return
+	}
+	f_sym5.charlatanExpect(func(errorf_sym6 func(string, ...interface{})) {
+		if unexpected_sym6 != 0 {
+			errorf_sym6("Recorder.Lookup called %d times without a configured hook", unexpected_sym6)
+		}
+	})
+
+	var unexpected_sym7 int
+	f_sym5.PutHook = func(map[string]Item, []byte) (err error) {
+		f_sym5.mutex.Lock()
+		unexpected_sym7++
+		f_sym5.mutex.Unlock()
+		return
+	}
+	f_sym5.charlatanExpect(func(errorf_sym7 func(string, ...interface{})) {
+		if unexpected_sym7 != 0 {
+			errorf_sym7("Recorder.Put called %d times without a configured hook", unexpected_sym7)
+		}
+	})
+
+	var unexpected_sym8 int
+	f_sym5.FlushHook = func() (n int, err error) {
+		f_sym5.mutex.Lock()
+		unexpected_sym8++
+		f_sym5.mutex.Unlock()
+		return
+	}
+	f_sym5.charlatanExpect(func(errorf_sym8 func(string, ...interface{})) {
+		if unexpected_sym8 != 0 {
+			errorf_sym8("Recorder.Flush called %d times without a configured hook", unexpected_sym8)
+		}
+	})
+
+	var unexpected_sym9 int
+	f_sym5.ConvertHook = func(interface{}) (ident1 interface{}) {
+		f_sym5.mutex.Lock()
+		unexpected_sym9++
+		f_sym5.mutex.Unlock()
+		return
+	}
+	f_sym5.charlatanExpect(func(errorf_sym9 func(string, ...interface{})) {
+		if unexpected_sym9 != 0 {
+			errorf_sym9("Recorder.Convert called %d times without a configured hook", unexpected_sym9)
+		}
+	})
+
+	var unexpected_sym10 int
+	f_sym5.CloseHook = func() {
+		f_sym5.mutex.Lock()
+		unexpected_sym10++
+		f_sym5.mutex.Unlock()
+		return
+	}
+	f_sym5.charlatanExpect(func(errorf_sym10 func(string, ...interface{})) {
+		if unexpected_sym10 != 0 {
+			errorf_sym10("Recorder.Close called %d times without a configured hook", unexpected_sym10)
+		}
+	})
+
+	t_sym5.Cleanup(func() {
+		f_sym5.mutex.RLock()
+		defer f_sym5.mutex.RUnlock()
+		for _, expectation_sym5 := range f_sym5.expectations {
+			expectation_sym5(t_sym5.Errorf)
+		}
+		if expectation_sym5 := f_sym5.hookExpectations["Lookup"]; expectation_sym5 != nil {
+			expectation_sym5(t_sym5.Errorf)
+		}
+		if expectation_sym5 := f_sym5.hookExpectations["Put"]; expectation_sym5 != nil {
+			expectation_sym5(t_sym5.Errorf)
+		}
+		if expectation_sym5 := f_sym5.hookExpectations["Flush"]; expectation_sym5 != nil {
+			expectation_sym5(t_sym5.Errorf)
+		}
+		if expectation_sym5 := f_sym5.hookExpectations["Convert"]; expectation_sym5 != nil {
+			expectation_sym5(t_sym5.Errorf)
+		}
+		if expectation_sym5 := f_sym5.hookExpectations["Close"]; expectation_sym5 != nil {
+			expectation_sym5(t_sym5.Errorf)
+		}
+	})
+
+	return f_sym5
+}
+
+// charlatanExpect registers an expectation of a strict fake, verified when the test ends.  The mutex must be held.
+func (f *FakeRecorder) charlatanExpect(expectation func(errorf func(string, ...interface{}))) {
+	if f.strict {
+		f.expectations = append(f.expectations, expectation)
+	}
+}
+
+// charlatanExpectHook replaces the expectation of the hook of the method of a strict fake, which is no longer
+// verified once the hook is replaced, a nil expectation expects nothing.  The mutex must be held.
+func (f *FakeRecorder) charlatanExpectHook(method string, expectation func(errorf func(string, ...interface{}))) {
+	if !f.strict {
+		return
+	}
+	if f.hookExpectations == nil {
+		f.hookExpectations = make(map[string]func(errorf func(string, ...interface{})))
+	}
+	f.hookExpectations[method] = expectation
+}
+
+// NewFakeRecorderRecord returns an instance of FakeRecorder with all hooks configured to call the given
+// implementation.  When the test ends the calls are written to the cassette file at path, see NewFakeRecorderReplay.
+func NewFakeRecorderRecord(t_sym11 interface {
+	RecorderTestingT
+	Cleanup(func())
+}, path_sym11 string, real_sym11 Recorder) *FakeRecorder {
+	f_sym11 := &FakeRecorder{}
+	cassette_sym11 := []json.Marshaler{}
+
+	f_sym11.LookupHook = func(ctx context.Context, key string, at time.Time) (item *Item, found bool, err error) {
+		item, found, err = real_sym11.Lookup(ctx, key, at)
+		invocation_sym11 := new(RecorderLookupInvocation)
+		invocation_sym11.Parameters.Ctx = ctx
+		invocation_sym11.Parameters.Key = key
+		invocation_sym11.Parameters.At = at
+		invocation_sym11.Results.Item = item
+		invocation_sym11.Results.Found = found
+		invocation_sym11.Results.Err = err
+		f_sym11.mutex.Lock()
+		cassette_sym11 = append(cassette_sym11, invocation_sym11)
+		f_sym11.mutex.Unlock()
+
+		return
+	}
+
+	f_sym11.PutHook = func(items map[string]Item, data []byte) (err error) {
+		err = real_sym11.Put(items, data)
+		invocation_sym11 := new(RecorderPutInvocation)
+		invocation_sym11.Parameters.Items = items
+		invocation_sym11.Parameters.Data = data
+		invocation_sym11.Results.Err = err
+		f_sym11.mutex.Lock()
+		cassette_sym11 = append(cassette_sym11, invocation_sym11)
+		f_sym11.mutex.Unlock()
+
+		return
+	}
+
+	f_sym11.FlushHook = func() (n int, err error) {
+		n, err = real_sym11.Flush()
+		invocation_sym11 := new(RecorderFlushInvocation)
+		invocation_sym11.Results.N = n
+		invocation_sym11.Results.Err = err
+		f_sym11.mutex.Lock()
+		cassette_sym11 = append(cassette_sym11, invocation_sym11)
+		f_sym11.mutex.Unlock()
+
+		return
+	}
+
+	f_sym11.ConvertHook = func(value interface{}) (ident1 interface{}) {
+		ident1 = real_sym11.Convert(value)
+		invocation_sym11 := new(RecorderConvertInvocation)
+		invocation_sym11.Parameters.Value = value
+		invocation_sym11.Results.Ident1 = ident1
+		f_sym11.mutex.Lock()
+		cassette_sym11 = append(cassette_sym11, invocation_sym11)
+		f_sym11.mutex.Unlock()
+
+		return
+	}
+
+	f_sym11.CloseHook = func() {
+		real_sym11.Close()
+		invocation_sym11 := new(RecorderCloseInvocation)
+		f_sym11.mutex.Lock()
+		cassette_sym11 = append(cassette_sym11, invocation_sym11)
+		f_sym11.mutex.Unlock()
+
+		return
+	}
+
+	t_sym11.Cleanup(func() {
+		f_sym11.mutex.RLock()
+		defer f_sym11.mutex.RUnlock()
+		data_sym11, err_sym11 := json.MarshalIndent(cassette_sym11, "", "\t")
+		if err_sym11 == nil {
+			err_sym11 = os.WriteFile(path_sym11, append(data_sym11, '\n'), 0644)
+		}
+		if err_sym11 != nil {
+			t_sym11.Errorf("cannot record cassette %s: %s", path_sym11, err_sym11)
+		}
+	})
+
+	return f_sym11
+}
+
+// NewFakeRecorderReplay returns an instance of FakeRecorder with all hooks configured to return the results
+// of the calls recorded in the cassette file at path by NewFakeRecorderRecord.  Each recorded call is
+// replayed once, to a call with the same parameters, other calls fail the test.
+func NewFakeRecorderReplay(t_sym12 RecorderTestingT, path_sym12 string) *FakeRecorder {
+	t_sym12.Helper()
+	f_sym12 := &FakeRecorder{}
+	data_sym12, err_sym12 := os.ReadFile(path_sym12)
+	if err_sym12 != nil {
+		t_sym12.Fatal("cannot replay cassette:", err_sym12)
+		return f_sym12
+	}
+	var entries_sym12 []json.RawMessage
+	if err_sym12 := json.Unmarshal(data_sym12, &entries_sym12); err_sym12 != nil {
+		t_sym12.Fatal("cannot replay cassette "+path_sym12+":", err_sym12)
+		return f_sym12
+	}
+
+	var callsLookup_sym12 []*RecorderLookupInvocation
+	var keysLookup_sym12 []string
+	var callsPut_sym12 []*RecorderPutInvocation
+	var keysPut_sym12 []string
+	var callsFlush_sym12 []*RecorderFlushInvocation
+	var keysFlush_sym12 []string
+	var callsConvert_sym12 []*RecorderConvertInvocation
+	var keysConvert_sym12 []string
+	var callsClose_sym12 []*RecorderCloseInvocation
+	var keysClose_sym12 []string
+	for _, entry_sym12 := range entries_sym12 {
+		var call_sym12 struct {
+			Interface string `json:"interface"`
+			Method    string `json:"method"`
+		}
+		if err_sym12 := json.Unmarshal(entry_sym12, &call_sym12); err_sym12 != nil {
+			t_sym12.Fatal("cannot replay cassette "+path_sym12+":", err_sym12)
+			return f_sym12
+		}
+		if call_sym12.Interface != "Recorder" {
+			continue
+		}
+
+		switch call_sym12.Method {
+		case "Lookup":
+			invocation_sym12 := new(RecorderLookupInvocation)
+			err_sym12 := json.Unmarshal(entry_sym12, invocation_sym12)
+			var key_sym12 string
+			if err_sym12 == nil {
+				key_sym12, err_sym12 = invocation_sym12.charlatanCassetteKey()
+			}
+			if err_sym12 != nil {
+				t_sym12.Fatal("cannot replay cassette "+path_sym12+":", err_sym12)
+				return f_sym12
+			}
+			callsLookup_sym12 = append(callsLookup_sym12, invocation_sym12)
+			keysLookup_sym12 = append(keysLookup_sym12, key_sym12)
+		case "Put":
+			invocation_sym12 := new(RecorderPutInvocation)
+			err_sym12 := json.Unmarshal(entry_sym12, invocation_sym12)
+			var key_sym12 string
+			if err_sym12 == nil {
+				key_sym12, err_sym12 = invocation_sym12.charlatanCassetteKey()
+			}
+			if err_sym12 != nil {
+				t_sym12.Fatal("cannot replay cassette "+path_sym12+":", err_sym12)
+				return f_sym12
+			}
+			callsPut_sym12 = append(callsPut_sym12, invocation_sym12)
+			keysPut_sym12 = append(keysPut_sym12, key_sym12)
+		case "Flush":
+			invocation_sym12 := new(RecorderFlushInvocation)
+			err_sym12 := json.Unmarshal(entry_sym12, invocation_sym12)
+			var key_sym12 string
+			if err_sym12 == nil {
+				key_sym12, err_sym12 = invocation_sym12.charlatanCassetteKey()
+			}
+			if err_sym12 != nil {
+				t_sym12.Fatal("cannot replay cassette "+path_sym12+":", err_sym12)
+				return f_sym12
+			}
+			callsFlush_sym12 = append(callsFlush_sym12, invocation_sym12)
+			keysFlush_sym12 = append(keysFlush_sym12, key_sym12)
+		case "Convert":
+			invocation_sym12 := new(RecorderConvertInvocation)
+			err_sym12 := json.Unmarshal(entry_sym12, invocation_sym12)
+			var key_sym12 string
+			if err_sym12 == nil {
+				key_sym12, err_sym12 = invocation_sym12.charlatanCassetteKey()
+			}
+			if err_sym12 != nil {
+				t_sym12.Fatal("cannot replay cassette "+path_sym12+":", err_sym12)
+				return f_sym12
+			}
+			callsConvert_sym12 = append(callsConvert_sym12, invocation_sym12)
+			keysConvert_sym12 = append(keysConvert_sym12, key_sym12)
+		case "Close":
+			invocation_sym12 := new(RecorderCloseInvocation)
+			err_sym12 := json.Unmarshal(entry_sym12, invocation_sym12)
+			var key_sym12 string
+			if err_sym12 == nil {
+				key_sym12, err_sym12 = invocation_sym12.charlatanCassetteKey()
+			}
+			if err_sym12 != nil {
+				t_sym12.Fatal("cannot replay cassette "+path_sym12+":", err_sym12)
+				return f_sym12
+			}
+			callsClose_sym12 = append(callsClose_sym12, invocation_sym12)
+			keysClose_sym12 = append(keysClose_sym12, key_sym12)
+		default:
+			t_sym12.Fatal("cannot replay cassette " + path_sym12 + ": unknown method Recorder." + call_sym12.Method)
+			return f_sym12
+		}
+	}
+
+	f_sym12.LookupHook = func(ctx context.Context, key string, at time.Time) (item *Item, found bool, err error) {
+		call_sym12 := new(RecorderLookupInvocation)
+		call_sym12.Parameters.Ctx = ctx
+		call_sym12.Parameters.Key = key
+		call_sym12.Parameters.At = at
+		key_sym12, err_sym12 := call_sym12.charlatanCassetteKey()
+		if err_sym12 != nil {
+			t_sym12.Errorf("Recorder.Lookup() called with parameters that cannot be encoded: %s", err_sym12)
+			return
+		}
+
+		f_sym12.mutex.Lock()
+		for i_sym12, recorded_sym12 := range callsLookup_sym12 {
+			if recorded_sym12 != nil && keysLookup_sym12[i_sym12] == key_sym12 {
+				callsLookup_sym12[i_sym12] = nil
+				f_sym12.mutex.Unlock()
+				item = recorded_sym12.Results.Item
+				found = recorded_sym12.Results.Found
+				err = recorded_sym12.Results.Err
+
+				return
+			}
+		}
+		f_sym12.mutex.Unlock()
+
+		t_sym12.Errorf("Recorder.Lookup() called with %s but no such call is left in cassette %s", key_sym12, path_sym12)
+		return
+	}
+
+	f_sym12.PutHook = func(items map[string]Item, data []byte) (err error) {
+		call_sym12 := new(RecorderPutInvocation)
+		call_sym12.Parameters.Items = items
+		call_sym12.Parameters.Data = data
+		key_sym12, err_sym12 := call_sym12.charlatanCassetteKey()
+		if err_sym12 != nil {
+			t_sym12.Errorf("Recorder.Put() called with parameters that cannot be encoded: %s", err_sym12)
+			return
+		}
+
+		f_sym12.mutex.Lock()
+		for i_sym12, recorded_sym12 := range callsPut_sym12 {
+			if recorded_sym12 != nil && keysPut_sym12[i_sym12] == key_sym12 {
+				callsPut_sym12[i_sym12] = nil
+				f_sym12.mutex.Unlock()
+				err = recorded_sym12.Results.Err
+
+				return
+			}
+		}
+		f_sym12.mutex.Unlock()
+
+		t_sym12.Errorf("Recorder.Put() called with %s but no such call is left in cassette %s", key_sym12, path_sym12)
+		return
+	}
+
+	f_sym12.FlushHook = func() (n int, err error) {
+		call_sym12 := new(RecorderFlushInvocation)
+		key_sym12, err_sym12 := call_sym12.charlatanCassetteKey()
+		if err_sym12 != nil {
+			t_sym12.Errorf("Recorder.Flush() called with parameters that cannot be encoded: %s", err_sym12)
+			return
+		}
+
+		f_sym12.mutex.Lock()
+		for i_sym12, recorded_sym12 := range callsFlush_sym12 {
+			if recorded_sym12 != nil && keysFlush_sym12[i_sym12] == key_sym12 {
+				callsFlush_sym12[i_sym12] = nil
+				f_sym12.mutex.Unlock()
+				n = recorded_sym12.Results.N
+				err = recorded_sym12.Results.Err
+
+				return
+			}
+		}
+		f_sym12.mutex.Unlock()
+
+		t_sym12.Errorf("Recorder.Flush() called with %s but no such call is left in cassette %s", key_sym12, path_sym12)
+		return
+	}
+
+	f_sym12.ConvertHook = func(value interface{}) (ident1 interface{}) {
+		call_sym12 := new(RecorderConvertInvocation)
+		call_sym12.Parameters.Value = value
+		key_sym12, err_sym12 := call_sym12.charlatanCassetteKey()
+		if err_sym12 != nil {
+			t_sym12.Errorf("Recorder.Convert() called with parameters that cannot be encoded: %s", err_sym12)
+			return
+		}
+
+		f_sym12.mutex.Lock()
+		for i_sym12, recorded_sym12 := range callsConvert_sym12 {
+			if recorded_sym12 != nil && keysConvert_sym12[i_sym12] == key_sym12 {
+				callsConvert_sym12[i_sym12] = nil
+				f_sym12.mutex.Unlock()
+				ident1 = recorded_sym12.Results.Ident1
+
+				return
+			}
+		}
+		f_sym12.mutex.Unlock()
+
+		t_sym12.Errorf("Recorder.Convert() called with %s but no such call is left in cassette %s", key_sym12, path_sym12)
+		return
+	}
+
+	f_sym12.CloseHook = func() {
+		call_sym12 := new(RecorderCloseInvocation)
+		key_sym12, err_sym12 := call_sym12.charlatanCassetteKey()
+		if err_sym12 != nil {
+			t_sym12.Errorf("Recorder.Close() called with parameters that cannot be encoded: %s", err_sym12)
+			return
+		}
+
+		f_sym12.mutex.Lock()
+		for i_sym12, recorded_sym12 := range callsClose_sym12 {
+			if recorded_sym12 != nil && keysClose_sym12[i_sym12] == key_sym12 {
+				callsClose_sym12[i_sym12] = nil
+				f_sym12.mutex.Unlock()
+
+				return
+			}
+		}
+		f_sym12.mutex.Unlock()
+
+		t_sym12.Errorf("Recorder.Close() called with %s but no such call is left in cassette %s", key_sym12, path_sym12)
+		return
+	}
+
+	return f_sym12
+}
+
+func (f *FakeRecorder) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.LookupCalls = []*RecorderLookupInvocation{}
+	f.PutCalls = []*RecorderPutInvocation{}
+	f.FlushCalls = []*RecorderFlushInvocation{}
+	f.ConvertCalls = []*RecorderConvertInvocation{}
+	f.CloseCalls = []*RecorderCloseInvocation{}
+	f.charlatanLookupCount = 0
+	f.charlatanPutCount = 0
+	f.charlatanFlushCount = 0
+	f.charlatanConvertCount = 0
+}
+
+// FailAll configures every method of Recorder whose last result is an error to return err and zero values, see
+// Set*Error.  A strict fake does not require the methods to be called.
+func (f *FakeRecorder) FailAll(err error) {
+	f.SetLookupError(err)
+	f.SetPutError(err)
+	f.SetFlushError(err)
+}
+
+func (f_sym13 *FakeRecorder) Lookup(ctx context.Context, key string, at time.Time) (item *Item, found bool, err error) {
+	f_sym13.mutex.Lock()
+	hook_sym13 := f_sym13.LookupHook
+	f_sym13.charlatanLookupCount++
+	results_sym13, onCall_sym13 := f_sym13.charlatanLookupOnCall[f_sym13.charlatanLookupCount]
+	if hook_sym13 == nil && !onCall_sym13 {
+		f_sym13.mutex.Unlock()
+		panic("Recorder.Lookup() called but FakeRecorder.LookupHook is nil")
+	}
+
+	invocation_sym13 := new(RecorderLookupInvocation)
+	invocation_sym13.Sequence = charlatanNextCall()
+	f_sym13.LookupCalls = append(f_sym13.LookupCalls, invocation_sym13)
+
+	invocation_sym13.Parameters.Ctx = ctx
+	invocation_sym13.Parameters.Key = key
+	invocation_sym13.Parameters.At = at
+
+	f_sym13.mutex.Unlock()
+
+	if onCall_sym13 {
+		item = results_sym13.Item
+		found = results_sym13.Found
+		err = results_sym13.Err
+	} else {
+		item, found, err = hook_sym13(ctx, key, at)
+	}
+
+	f_sym13.mutex.Lock()
+	invocation_sym13.Results.Item = item
+	invocation_sym13.Results.Found = found
+	invocation_sym13.Results.Err = err
+	f_sym13.mutex.Unlock()
+
+	return
+}
+
+// LookupCallsSnapshot returns a copy of the calls of FakeRecorder.Lookup, which can be inspected while the fake is in use
+func (f_sym14 *FakeRecorder) LookupCallsSnapshot() []*RecorderLookupInvocation {
+	f_sym14.mutex.RLock()
+	defer f_sym14.mutex.RUnlock()
+
+	calls_sym14 := make([]*RecorderLookupInvocation, len(f_sym14.LookupCalls))
+	for i_sym14, call_sym14 := range f_sym14.LookupCalls {
+		snapshot_sym14 := *call_sym14
+		calls_sym14[i_sym14] = &snapshot_sym14
+	}
+
+	return calls_sym14
+}
+
+// SetLookupStub configures Recorder.Lookup to always return the given values
+func (f_sym15 *FakeRecorder) SetLookupStub(item *Item, found bool, err error) {
+	f_sym15.mutex.Lock()
+	defer f_sym15.mutex.Unlock()
+	var used_sym15 bool
+	f_sym15.charlatanExpectHook("Lookup", func(errorf_sym15 func(string, ...interface{})) {
+		if !used_sym15 {
+			errorf_sym15("FakeRecorder.SetLookupStub configured but Recorder.Lookup not called")
+		}
+	})
+	f_sym15.LookupHook = func(context.Context, string, time.Time) (*Item, bool, error) {
+		f_sym15.mutex.Lock()
+		used_sym15 = true
+		f_sym15.mutex.Unlock()
+		return item, found, err
+	}
+}
+
+// SetLookupError configures Recorder.Lookup to always return err and zero values.  Unlike a stub, a strict
+// fake does not require the method to be called.
+func (f_sym16 *FakeRecorder) SetLookupError(err_sym16 error) {
+	f_sym16.mutex.Lock()
+	defer f_sym16.mutex.Unlock()
+	f_sym16.charlatanExpectHook("Lookup", nil)
+	f_sym16.LookupHook = func(context.Context, string, time.Time) (*Item, bool, error) {
+		return nil, false, err_sym16
+	}
+}
+
+// RecorderLookupResults holds the results of a call of FakeRecorder.Lookup, see SetLookupStubSequence
+type RecorderLookupResults struct {
+	Item  *Item
+	Found bool
+	Err   error
+}
+
+// SetLookupStubSequence configures Recorder.Lookup to return the given results in order, one per call.
+// Once the results are exhausted the last results are returned again.
+func (f *FakeRecorder) SetLookupStubSequence(results ...RecorderLookupResults) {
+	f.SetLookupStubSequenceExhausted(CharlatanRepeatLast(), results...)
+}
+
+// SetLookupStubSequenceExhausted configures Recorder.Lookup to return the given results in order, one
+// per call.  Once the results are exhausted each call is reported to exhausted, then the last results are returned.
+func (f_sym17 *FakeRecorder) SetLookupStubSequenceExhausted(exhausted_sym17 CharlatanExhausted, results_sym17 ...RecorderLookupResults) {
+	f_sym17.mutex.Lock()
+	defer f_sym17.mutex.Unlock()
+	var calls_sym17 int
+	f_sym17.charlatanExpectHook("Lookup", func(errorf_sym17 func(string, ...interface{})) {
+		if calls_sym17 < len(results_sym17) {
+			errorf_sym17("FakeRecorder.SetLookupStubSequence configured with %d results but Recorder.Lookup called %d times", len(results_sym17), calls_sym17)
+		}
+	})
+	f_sym17.LookupHook = func(context.Context, string, time.Time) (item *Item, found bool, err error) {
+		f_sym17.mutex.Lock()
+		call_sym17 := calls_sym17
+		calls_sym17++
+		f_sym17.mutex.Unlock()
+		if call_sym17 >= len(results_sym17) {
+			exhausted_sym17("Recorder.Lookup", len(results_sym17))
+			if len(results_sym17) == 0 {
+				return
+			}
+			call_sym17 = len(results_sym17) - 1
+		}
+
+		item = results_sym17[call_sym17].Item
+		found = results_sym17[call_sym17].Found
+		err = results_sym17[call_sym17].Err
+
+		return
+	}
+}
+
+// SetLookupStubOnCall configures Recorder.Lookup to return the given values on the nth call, counting from 1
+// since the fake was created or Reset.  Other calls are passed to the hook.
+func (f_sym18 *FakeRecorder) SetLookupStubOnCall(n_sym18 int, item *Item, found bool, err error) {
+	f_sym18.mutex.Lock()
+	defer f_sym18.mutex.Unlock()
+	if f_sym18.charlatanLookupOnCall == nil {
+		f_sym18.charlatanLookupOnCall = make(map[int]RecorderLookupResults)
+	}
+	f_sym18.charlatanLookupOnCall[n_sym18] = RecorderLookupResults{Item: item, Found: found, Err: err}
+	f_sym18.charlatanExpect(func(errorf_sym18 func(string, ...interface{})) {
+		if f_sym18.charlatanLookupCount < n_sym18 {
+			errorf_sym18("FakeRecorder.SetLookupStubOnCall configured for call %d but Recorder.Lookup called %d times", n_sym18, f_sym18.charlatanLookupCount)
+		}
+	})
+}
+
+// SetLookupErrorOnCall configures Recorder.Lookup to return err and zero values on the nth call, counting
+// from 1 since the fake was created or Reset.  Other calls are passed to the hook.
+func (f *FakeRecorder) SetLookupErrorOnCall(n int, err error) {
+	f.SetLookupStubOnCall(n, nil, false, err)
+}
+
+// SetLookupInvocation configures Recorder.Lookup to return the given results when called with the given parameters
+// If no match is found for an invocation the result(s) of the fallback function are returned
+func (f_sym19 *FakeRecorder) SetLookupInvocation(calls_sym19 []*RecorderLookupInvocation, fallback_sym19 func() (*Item, bool, error)) {
+	f_sym19.mutex.Lock()
+	defer f_sym19.mutex.Unlock()
+	matched_sym19 := make([]bool, len(calls_sym19))
+	f_sym19.charlatanExpectHook("Lookup", func(errorf_sym19 func(string, ...interface{})) {
+		for i_sym19, call_sym19 := range calls_sym19 {
+			if !matched_sym19[i_sym19] {
+				errorf_sym19("FakeRecorder.SetLookupInvocation configured with %+v but Recorder.Lookup not called with those parameters", call_sym19.Parameters)
+			}
+		}
+	})
+	f_sym19.LookupHook = func(ctx context.Context, key string, at time.Time) (item *Item, found bool, err error) {
+		for i_sym19, call_sym19 := range calls_sym19 {
+			if reflect.DeepEqual(call_sym19.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym19.Parameters.Key, key) && reflect.DeepEqual(call_sym19.Parameters.At, at) {
+				f_sym19.mutex.Lock()
+				matched_sym19[i_sym19] = true
+				f_sym19.mutex.Unlock()
+				item = call_sym19.Results.Item
+				found = call_sym19.Results.Found
+				err = call_sym19.Results.Err
+
+				return
+			}
+		}
+
+		return fallback_sym19()
+	}
+}
+
+// SetLookupInvocationMatch configures Recorder.Lookup to return the given results when its parameters
+// are matched by the given matchers, one per parameter.  Other calls are passed to the previously configured hook.
+func (f_sym20 *FakeRecorder) SetLookupInvocationMatch(ctx CharlatanMatcher, key CharlatanMatcher, at CharlatanMatcher, item *Item, found bool, err error) {
+	f_sym20.mutex.Lock()
+	defer f_sym20.mutex.Unlock()
+	matchers_sym20 := []CharlatanMatcher{ctx, key, at}
+	previous_sym20 := f_sym20.LookupHook
+	var used_sym20 bool
+	expected_sym20 := f_sym20.hookExpectations["Lookup"]
+	f_sym20.charlatanExpectHook("Lookup", func(errorf_sym20 func(string, ...interface{})) {
+		// N.B. - the previous hook still receives the unmatched calls
+		if expected_sym20 != nil {
+			expected_sym20(errorf_sym20)
+		}
+		if !used_sym20 {
+			errorf_sym20("FakeRecorder.SetLookupInvocationMatch configured but Recorder.Lookup not called with matching parameters")
+		}
+	})
+	f_sym20.LookupHook = func(ctx context.Context, key string, at time.Time) (*Item, bool, error) {
+		if matchers_sym20[0].Match(ctx) && matchers_sym20[1].Match(key) && matchers_sym20[2].Match(at) {
+			f_sym20.mutex.Lock()
+			used_sym20 = true
+			f_sym20.mutex.Unlock()
+			return item, found, err
+		}
+		if previous_sym20 == nil {
+			panic("Recorder.Lookup() called with unmatched parameters but FakeRecorder.LookupHook has no previous hook")
+		}
+
+		return previous_sym20(ctx, key, at)
+	}
+}
+
+// LookupCalled returns true if FakeRecorder.Lookup was called
+func (f *FakeRecorder) LookupCalled() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.LookupCalls) != 0
+}
+
+// AssertLookupCalled calls t.Error if FakeRecorder.Lookup was not called
+func (f *FakeRecorder) AssertLookupCalled(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.LookupCalls) == 0 {
+		t.Error("FakeRecorder.Lookup not called, expected at least one")
+	}
+}
+
+// LookupNotCalled returns true if FakeRecorder.Lookup was not called
+func (f *FakeRecorder) LookupNotCalled() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.LookupCalls) == 0
+}
+
+// AssertLookupNotCalled calls t.Error if FakeRecorder.Lookup was called
+func (f *FakeRecorder) AssertLookupNotCalled(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.LookupCalls) != 0 {
+		t.Error("FakeRecorder.Lookup called, expected none")
+	}
+}
+
+// LookupCalledOnce returns true if FakeRecorder.Lookup was called exactly once
+func (f *FakeRecorder) LookupCalledOnce() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.LookupCalls) == 1
+}
+
+// AssertLookupCalledOnce calls t.Error if FakeRecorder.Lookup was not called exactly once
+func (f *FakeRecorder) AssertLookupCalledOnce(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.LookupCalls) != 1 {
+		t.Errorf("FakeRecorder.Lookup called %d times, expected 1", len(f.LookupCalls))
+	}
+}
+
+// LookupCalledN returns true if FakeRecorder.Lookup was called at least n times
+func (f *FakeRecorder) LookupCalledN(n int) bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.LookupCalls) >= n
+}
+
+// AssertLookupCalledN calls t.Error if FakeRecorder.Lookup was called less than n times
+func (f *FakeRecorder) AssertLookupCalledN(t RecorderTestingT, n int) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.LookupCalls) < n {
+		t.Errorf("FakeRecorder.Lookup called %d times, expected >= %d", len(f.LookupCalls), n)
+	}
+}
+
+// LookupCalledWith returns true if FakeRecorder.Lookup was called with the given values
+func (f_sym21 *FakeRecorder) LookupCalledWith(ctx context.Context, key string, at time.Time) bool {
+	f_sym21.mutex.RLock()
+	defer f_sym21.mutex.RUnlock()
+	for _, call_sym21 := range f_sym21.LookupCalls {
+		if reflect.DeepEqual(call_sym21.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym21.Parameters.Key, key) && reflect.DeepEqual(call_sym21.Parameters.At, at) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertLookupCalledWith calls t.Error if FakeRecorder.Lookup was not called with the given values
+func (f_sym22 *FakeRecorder) AssertLookupCalledWith(t RecorderTestingT, ctx context.Context, key string, at time.Time) {
+	t.Helper()
+	f_sym22.mutex.RLock()
+	defer f_sym22.mutex.RUnlock()
+	var found_sym22 bool
+	for _, call_sym22 := range f_sym22.LookupCalls {
+		if reflect.DeepEqual(call_sym22.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym22.Parameters.Key, key) && reflect.DeepEqual(call_sym22.Parameters.At, at) {
+			found_sym22 = true
+			break
+		}
+	}
+
+	if !found_sym22 {
+		t.Error("FakeRecorder.Lookup not called with expected parameters")
+	}
+}
+
+// LookupCalledWithMatch returns true if FakeRecorder.Lookup was called with parameters matched by the given matchers, one per parameter
+func (f_sym23 *FakeRecorder) LookupCalledWithMatch(ctx CharlatanMatcher, key CharlatanMatcher, at CharlatanMatcher) bool {
+	f_sym23.mutex.RLock()
+	defer f_sym23.mutex.RUnlock()
+	for _, call_sym23 := range f_sym23.LookupCalls {
+		if ctx.Match(call_sym23.Parameters.Ctx) && key.Match(call_sym23.Parameters.Key) && at.Match(call_sym23.Parameters.At) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertLookupCalledWithMatch calls t.Error if FakeRecorder.Lookup was not called with parameters matched by the given matchers, one per parameter
+func (f_sym24 *FakeRecorder) AssertLookupCalledWithMatch(t RecorderTestingT, ctx CharlatanMatcher, key CharlatanMatcher, at CharlatanMatcher) {
+	t.Helper()
+	f_sym24.mutex.RLock()
+	defer f_sym24.mutex.RUnlock()
+	for _, call_sym24 := range f_sym24.LookupCalls {
+		if ctx.Match(call_sym24.Parameters.Ctx) && key.Match(call_sym24.Parameters.Key) && at.Match(call_sym24.Parameters.At) {
+			return
+		}
+	}
+
+	t.Error("FakeRecorder.Lookup not called with matching parameters")
+}
+
+// LookupCalledOnceWith returns true if FakeRecorder.Lookup was called exactly once with the given values
+func (f_sym25 *FakeRecorder) LookupCalledOnceWith(ctx context.Context, key string, at time.Time) bool {
+	f_sym25.mutex.RLock()
+	defer f_sym25.mutex.RUnlock()
+	var count_sym25 int
+	for _, call_sym25 := range f_sym25.LookupCalls {
+		if reflect.DeepEqual(call_sym25.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym25.Parameters.Key, key) && reflect.DeepEqual(call_sym25.Parameters.At, at) {
+			count_sym25++
+		}
+	}
+
+	return count_sym25 == 1
+}
+
+// AssertLookupCalledOnceWith calls t.Error if FakeRecorder.Lookup was not called exactly once with the given values
+func (f_sym26 *FakeRecorder) AssertLookupCalledOnceWith(t RecorderTestingT, ctx context.Context, key string, at time.Time) {
+	t.Helper()
+	f_sym26.mutex.RLock()
+	defer f_sym26.mutex.RUnlock()
+	var count_sym26 int
+	for _, call_sym26 := range f_sym26.LookupCalls {
+		if reflect.DeepEqual(call_sym26.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym26.Parameters.Key, key) && reflect.DeepEqual(call_sym26.Parameters.At, at) {
+			count_sym26++
+		}
+	}
+
+	if count_sym26 != 1 {
+		t.Errorf("FakeRecorder.Lookup called %d times with expected parameters, expected one", count_sym26)
+	}
+}
+
+// LookupResultsForCall returns the result values for the first call to FakeRecorder.Lookup with the given values
+func (f_sym27 *FakeRecorder) LookupResultsForCall(ctx context.Context, key string, at time.Time) (item *Item, found bool, err error, found_sym27 bool) {
+	f_sym27.mutex.RLock()
+	defer f_sym27.mutex.RUnlock()
+	for _, call_sym27 := range f_sym27.LookupCalls {
+		if reflect.DeepEqual(call_sym27.Parameters.Ctx, ctx) && reflect.DeepEqual(call_sym27.Parameters.Key, key) && reflect.DeepEqual(call_sym27.Parameters.At, at) {
+			item = call_sym27.Results.Item
+			found = call_sym27.Results.Found
+			err = call_sym27.Results.Err
+			found_sym27 = true
+			break
+		}
+	}
+
+	return
+}
+
+func (f_sym28 *FakeRecorder) Put(items map[string]Item, data []byte) (err error) {
+	f_sym28.mutex.Lock()
+	hook_sym28 := f_sym28.PutHook
+	f_sym28.charlatanPutCount++
+	results_sym28, onCall_sym28 := f_sym28.charlatanPutOnCall[f_sym28.charlatanPutCount]
+	if hook_sym28 == nil && !onCall_sym28 {
+		f_sym28.mutex.Unlock()
+		panic("Recorder.Put() called but FakeRecorder.PutHook is nil")
+	}
+
+	invocation_sym28 := new(RecorderPutInvocation)
+	invocation_sym28.Sequence = charlatanNextCall()
+	f_sym28.PutCalls = append(f_sym28.PutCalls, invocation_sym28)
+
+	invocation_sym28.Parameters.Items = items
+	invocation_sym28.Parameters.Data = data
+
+	f_sym28.mutex.Unlock()
+
+	if onCall_sym28 {
+		err = results_sym28.Err
+	} else {
+		err = hook_sym28(items, data)
+	}
+
+	f_sym28.mutex.Lock()
+	invocation_sym28.Results.Err = err
+	f_sym28.mutex.Unlock()
+
+	return
+}
+
+// PutCallsSnapshot returns a copy of the calls of FakeRecorder.Put, which can be inspected while the fake is in use
+func (f_sym29 *FakeRecorder) PutCallsSnapshot() []*RecorderPutInvocation {
+	f_sym29.mutex.RLock()
+	defer f_sym29.mutex.RUnlock()
+
+	calls_sym29 := make([]*RecorderPutInvocation, len(f_sym29.PutCalls))
+	for i_sym29, call_sym29 := range f_sym29.PutCalls {
+		snapshot_sym29 := *call_sym29
+		calls_sym29[i_sym29] = &snapshot_sym29
+	}
+
+	return calls_sym29
+}
+
+// SetPutStub configures Recorder.Put to always return the given values
+func (f_sym30 *FakeRecorder) SetPutStub(err error) {
+	f_sym30.mutex.Lock()
+	defer f_sym30.mutex.Unlock()
+	var used_sym30 bool
+	f_sym30.charlatanExpectHook("Put", func(errorf_sym30 func(string, ...interface{})) {
+		if !used_sym30 {
+			errorf_sym30("FakeRecorder.SetPutStub configured but Recorder.Put not called")
+		}
+	})
+	f_sym30.PutHook = func(map[string]Item, []byte) error {
+		f_sym30.mutex.Lock()
+		used_sym30 = true
+		f_sym30.mutex.Unlock()
+		return err
+	}
+}
+
+// SetPutError configures Recorder.Put to always return err and zero values.  Unlike a stub, a strict
+// fake does not require the method to be called.
+func (f_sym31 *FakeRecorder) SetPutError(err_sym31 error) {
+	f_sym31.mutex.Lock()
+	defer f_sym31.mutex.Unlock()
+	f_sym31.charlatanExpectHook("Put", nil)
+	f_sym31.PutHook = func(map[string]Item, []byte) error {
+		return err_sym31
+	}
+}
+
+// RecorderPutResults holds the results of a call of FakeRecorder.Put, see SetPutStubSequence
+type RecorderPutResults struct {
+	Err error
+}
+
+// SetPutStubSequence configures Recorder.Put to return the given results in order, one per call.
+// Once the results are exhausted the last results are returned again.
+func (f *FakeRecorder) SetPutStubSequence(results ...RecorderPutResults) {
+	f.SetPutStubSequenceExhausted(CharlatanRepeatLast(), results...)
+}
+
+// SetPutStubSequenceExhausted configures Recorder.Put to return the given results in order, one
+// per call.  Once the results are exhausted each call is reported to exhausted, then the last results are returned.
+func (f_sym32 *FakeRecorder) SetPutStubSequenceExhausted(exhausted_sym32 CharlatanExhausted, results_sym32 ...RecorderPutResults) {
+	f_sym32.mutex.Lock()
+	defer f_sym32.mutex.Unlock()
+	var calls_sym32 int
+	f_sym32.charlatanExpectHook("Put", func(errorf_sym32 func(string, ...interface{})) {
+		if calls_sym32 < len(results_sym32) {
+			errorf_sym32("FakeRecorder.SetPutStubSequence configured with %d results but Recorder.Put called %d times", len(results_sym32), calls_sym32)
+		}
+	})
+	f_sym32.PutHook = func(map[string]Item, []byte) (err error) {
+		f_sym32.mutex.Lock()
+		call_sym32 := calls_sym32
+		calls_sym32++
+		f_sym32.mutex.Unlock()
+		if call_sym32 >= len(results_sym32) {
+			exhausted_sym32("Recorder.Put", len(results_sym32))
+			if len(results_sym32) == 0 {
+				return
+			}
+			call_sym32 = len(results_sym32) - 1
+		}
+
+		err = results_sym32[call_sym32].Err
+
+		return
+	}
+}
+
+// SetPutStubOnCall configures Recorder.Put to return the given values on the nth call, counting from 1
+// since the fake was created or Reset.  Other calls are passed to the hook.
+func (f_sym33 *FakeRecorder) SetPutStubOnCall(n_sym33 int, err error) {
+	f_sym33.mutex.Lock()
+	defer f_sym33.mutex.Unlock()
+	if f_sym33.charlatanPutOnCall == nil {
+		f_sym33.charlatanPutOnCall = make(map[int]RecorderPutResults)
+	}
+	f_sym33.charlatanPutOnCall[n_sym33] = RecorderPutResults{Err: err}
+	f_sym33.charlatanExpect(func(errorf_sym33 func(string, ...interface{})) {
+		if f_sym33.charlatanPutCount < n_sym33 {
+			errorf_sym33("FakeRecorder.SetPutStubOnCall configured for call %d but Recorder.Put called %d times", n_sym33, f_sym33.charlatanPutCount)
+		}
+	})
+}
+
+// SetPutErrorOnCall configures Recorder.Put to return err and zero values on the nth call, counting
+// from 1 since the fake was created or Reset.  Other calls are passed to the hook.
+func (f *FakeRecorder) SetPutErrorOnCall(n int, err error) {
+	f.SetPutStubOnCall(n, err)
+}
+
+// SetPutInvocation configures Recorder.Put to return the given results when called with the given parameters
+// If no match is found for an invocation the result(s) of the fallback function are returned
+func (f_sym34 *FakeRecorder) SetPutInvocation(calls_sym34 []*RecorderPutInvocation, fallback_sym34 func() error) {
+	f_sym34.mutex.Lock()
+	defer f_sym34.mutex.Unlock()
+	matched_sym34 := make([]bool, len(calls_sym34))
+	f_sym34.charlatanExpectHook("Put", func(errorf_sym34 func(string, ...interface{})) {
+		for i_sym34, call_sym34 := range calls_sym34 {
+			if !matched_sym34[i_sym34] {
+				errorf_sym34("FakeRecorder.SetPutInvocation configured with %+v but Recorder.Put not called with those parameters", call_sym34.Parameters)
+			}
+		}
+	})
+	f_sym34.PutHook = func(items map[string]Item, data []byte) (err error) {
+		for i_sym34, call_sym34 := range calls_sym34 {
+			if reflect.DeepEqual(call_sym34.Parameters.Items, items) && reflect.DeepEqual(call_sym34.Parameters.Data, data) {
+				f_sym34.mutex.Lock()
+				matched_sym34[i_sym34] = true
+				f_sym34.mutex.Unlock()
+				err = call_sym34.Results.Err
+
+				return
+			}
+		}
+
+		return fallback_sym34()
+	}
+}
+
+// SetPutInvocationMatch configures Recorder.Put to return the given results when its parameters
+// are matched by the given matchers, one per parameter.  Other calls are passed to the previously configured hook.
+func (f_sym35 *FakeRecorder) SetPutInvocationMatch(items CharlatanMatcher, data CharlatanMatcher, err error) {
+	f_sym35.mutex.Lock()
+	defer f_sym35.mutex.Unlock()
+	matchers_sym35 := []CharlatanMatcher{items, data}
+	previous_sym35 := f_sym35.PutHook
+	var used_sym35 bool
+	expected_sym35 := f_sym35.hookExpectations["Put"]
+	f_sym35.charlatanExpectHook("Put", func(errorf_sym35 func(string, ...interface{})) {
+		// N.B. - the previous hook still receives the unmatched calls
+		if expected_sym35 != nil {
+			expected_sym35(errorf_sym35)
+		}
+		if !used_sym35 {
+			errorf_sym35("FakeRecorder.SetPutInvocationMatch configured but Recorder.Put not called with matching parameters")
+		}
+	})
+	f_sym35.PutHook = func(items map[string]Item, data []byte) error {
+		if matchers_sym35[0].Match(items) && matchers_sym35[1].Match(data) {
+			f_sym35.mutex.Lock()
+			used_sym35 = true
+			f_sym35.mutex.Unlock()
+			return err
+		}
+		if previous_sym35 == nil {
+			panic("Recorder.Put() called with unmatched parameters but FakeRecorder.PutHook has no previous hook")
+		}
+
+		return previous_sym35(items, data)
+	}
+}
+
+// PutCalled returns true if FakeRecorder.Put was called
+func (f *FakeRecorder) PutCalled() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.PutCalls) != 0
+}
+
+// AssertPutCalled calls t.Error if FakeRecorder.Put was not called
+func (f *FakeRecorder) AssertPutCalled(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.PutCalls) == 0 {
+		t.Error("FakeRecorder.Put not called, expected at least one")
+	}
+}
+
+// PutNotCalled returns true if FakeRecorder.Put was not called
+func (f *FakeRecorder) PutNotCalled() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.PutCalls) == 0
+}
+
+// AssertPutNotCalled calls t.Error if FakeRecorder.Put was called
+func (f *FakeRecorder) AssertPutNotCalled(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.PutCalls) != 0 {
+		t.Error("FakeRecorder.Put called, expected none")
+	}
+}
+
+// PutCalledOnce returns true if FakeRecorder.Put was called exactly once
+func (f *FakeRecorder) PutCalledOnce() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.PutCalls) == 1
+}
+
+// AssertPutCalledOnce calls t.Error if FakeRecorder.Put was not called exactly once
+func (f *FakeRecorder) AssertPutCalledOnce(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.PutCalls) != 1 {
+		t.Errorf("FakeRecorder.Put called %d times, expected 1", len(f.PutCalls))
+	}
+}
+
+// PutCalledN returns true if FakeRecorder.Put was called at least n times
+func (f *FakeRecorder) PutCalledN(n int) bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.PutCalls) >= n
+}
+
+// AssertPutCalledN calls t.Error if FakeRecorder.Put was called less than n times
+func (f *FakeRecorder) AssertPutCalledN(t RecorderTestingT, n int) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.PutCalls) < n {
+		t.Errorf("FakeRecorder.Put called %d times, expected >= %d", len(f.PutCalls), n)
+	}
+}
+
+// PutCalledWith returns true if FakeRecorder.Put was called with the given values
+func (f_sym36 *FakeRecorder) PutCalledWith(items map[string]Item, data []byte) bool {
+	f_sym36.mutex.RLock()
+	defer f_sym36.mutex.RUnlock()
+	for _, call_sym36 := range f_sym36.PutCalls {
+		if reflect.DeepEqual(call_sym36.Parameters.Items, items) && reflect.DeepEqual(call_sym36.Parameters.Data, data) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertPutCalledWith calls t.Error if FakeRecorder.Put was not called with the given values
+func (f_sym37 *FakeRecorder) AssertPutCalledWith(t RecorderTestingT, items map[string]Item, data []byte) {
+	t.Helper()
+	f_sym37.mutex.RLock()
+	defer f_sym37.mutex.RUnlock()
+	var found_sym37 bool
+	for _, call_sym37 := range f_sym37.PutCalls {
+		if reflect.DeepEqual(call_sym37.Parameters.Items, items) && reflect.DeepEqual(call_sym37.Parameters.Data, data) {
+			found_sym37 = true
+			break
+		}
+	}
+
+	if !found_sym37 {
+		t.Error("FakeRecorder.Put not called with expected parameters")
+	}
+}
+
+// PutCalledWithMatch returns true if FakeRecorder.Put was called with parameters matched by the given matchers, one per parameter
+func (f_sym38 *FakeRecorder) PutCalledWithMatch(items CharlatanMatcher, data CharlatanMatcher) bool {
+	f_sym38.mutex.RLock()
+	defer f_sym38.mutex.RUnlock()
+	for _, call_sym38 := range f_sym38.PutCalls {
+		if items.Match(call_sym38.Parameters.Items) && data.Match(call_sym38.Parameters.Data) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertPutCalledWithMatch calls t.Error if FakeRecorder.Put was not called with parameters matched by the given matchers, one per parameter
+func (f_sym39 *FakeRecorder) AssertPutCalledWithMatch(t RecorderTestingT, items CharlatanMatcher, data CharlatanMatcher) {
+	t.Helper()
+	f_sym39.mutex.RLock()
+	defer f_sym39.mutex.RUnlock()
+	for _, call_sym39 := range f_sym39.PutCalls {
+		if items.Match(call_sym39.Parameters.Items) && data.Match(call_sym39.Parameters.Data) {
+			return
+		}
+	}
+
+	t.Error("FakeRecorder.Put not called with matching parameters")
+}
+
+// PutCalledOnceWith returns true if FakeRecorder.Put was called exactly once with the given values
+func (f_sym40 *FakeRecorder) PutCalledOnceWith(items map[string]Item, data []byte) bool {
+	f_sym40.mutex.RLock()
+	defer f_sym40.mutex.RUnlock()
+	var count_sym40 int
+	for _, call_sym40 := range f_sym40.PutCalls {
+		if reflect.DeepEqual(call_sym40.Parameters.Items, items) && reflect.DeepEqual(call_sym40.Parameters.Data, data) {
+			count_sym40++
+		}
+	}
+
+	return count_sym40 == 1
+}
+
+// AssertPutCalledOnceWith calls t.Error if FakeRecorder.Put was not called exactly once with the given values
+func (f_sym41 *FakeRecorder) AssertPutCalledOnceWith(t RecorderTestingT, items map[string]Item, data []byte) {
+	t.Helper()
+	f_sym41.mutex.RLock()
+	defer f_sym41.mutex.RUnlock()
+	var count_sym41 int
+	for _, call_sym41 := range f_sym41.PutCalls {
+		if reflect.DeepEqual(call_sym41.Parameters.Items, items) && reflect.DeepEqual(call_sym41.Parameters.Data, data) {
+			count_sym41++
+		}
+	}
+
+	if count_sym41 != 1 {
+		t.Errorf("FakeRecorder.Put called %d times with expected parameters, expected one", count_sym41)
+	}
+}
+
+// PutResultsForCall returns the result values for the first call to FakeRecorder.Put with the given values
+func (f_sym42 *FakeRecorder) PutResultsForCall(items map[string]Item, data []byte) (err error, found_sym42 bool) {
+	f_sym42.mutex.RLock()
+	defer f_sym42.mutex.RUnlock()
+	for _, call_sym42 := range f_sym42.PutCalls {
+		if reflect.DeepEqual(call_sym42.Parameters.Items, items) && reflect.DeepEqual(call_sym42.Parameters.Data, data) {
+			err = call_sym42.Results.Err
+			found_sym42 = true
+			break
+		}
+	}
+
+	return
+}
+
+func (f_sym43 *FakeRecorder) Flush() (n int, err error) {
+	f_sym43.mutex.Lock()
+	hook_sym43 := f_sym43.FlushHook
+	f_sym43.charlatanFlushCount++
+	results_sym43, onCall_sym43 := f_sym43.charlatanFlushOnCall[f_sym43.charlatanFlushCount]
+	if hook_sym43 == nil && !onCall_sym43 {
+		f_sym43.mutex.Unlock()
+		panic("Recorder.Flush() called but FakeRecorder.FlushHook is nil")
+	}
+
+	invocation_sym43 := new(RecorderFlushInvocation)
+	invocation_sym43.Sequence = charlatanNextCall()
+	f_sym43.FlushCalls = append(f_sym43.FlushCalls, invocation_sym43)
+
+	f_sym43.mutex.Unlock()
+
+	if onCall_sym43 {
+		n = results_sym43.N
+		err = results_sym43.Err
+	} else {
+		n, err = hook_sym43()
+	}
+
+	f_sym43.mutex.Lock()
+	invocation_sym43.Results.N = n
+	invocation_sym43.Results.Err = err
+	f_sym43.mutex.Unlock()
+
+	return
+}
+
+// FlushCallsSnapshot returns a copy of the calls of FakeRecorder.Flush, which can be inspected while the fake is in use
+func (f_sym44 *FakeRecorder) FlushCallsSnapshot() []*RecorderFlushInvocation {
+	f_sym44.mutex.RLock()
+	defer f_sym44.mutex.RUnlock()
+
+	calls_sym44 := make([]*RecorderFlushInvocation, len(f_sym44.FlushCalls))
+	for i_sym44, call_sym44 := range f_sym44.FlushCalls {
+		snapshot_sym44 := *call_sym44
+		calls_sym44[i_sym44] = &snapshot_sym44
+	}
+
+	return calls_sym44
+}
+
+// SetFlushStub configures Recorder.Flush to always return the given values
+func (f_sym45 *FakeRecorder) SetFlushStub(n int, err error) {
+	f_sym45.mutex.Lock()
+	defer f_sym45.mutex.Unlock()
+	var used_sym45 bool
+	f_sym45.charlatanExpectHook("Flush", func(errorf_sym45 func(string, ...interface{})) {
+		if !used_sym45 {
+			errorf_sym45("FakeRecorder.SetFlushStub configured but Recorder.Flush not called")
+		}
+	})
+	f_sym45.FlushHook = func() (int, error) {
+		f_sym45.mutex.Lock()
+		used_sym45 = true
+		f_sym45.mutex.Unlock()
+		return n, err
+	}
+}
+
+// SetFlushError configures Recorder.Flush to always return err and zero values.  Unlike a stub, a strict
+// fake does not require the method to be called.
+func (f_sym46 *FakeRecorder) SetFlushError(err_sym46 error) {
+	f_sym46.mutex.Lock()
+	defer f_sym46.mutex.Unlock()
+	f_sym46.charlatanExpectHook("Flush", nil)
+	f_sym46.FlushHook = func() (int, error) {
+		return 0, err_sym46
+	}
+}
+
+// RecorderFlushResults holds the results of a call of FakeRecorder.Flush, see SetFlushStubSequence
+type RecorderFlushResults struct {
+	N   int
+	Err error
+}
+
+// SetFlushStubSequence configures Recorder.Flush to return the given results in order, one per call.
+// Once the results are exhausted the last results are returned again.
+func (f *FakeRecorder) SetFlushStubSequence(results ...RecorderFlushResults) {
+	f.SetFlushStubSequenceExhausted(CharlatanRepeatLast(), results...)
+}
+
+// SetFlushStubSequenceExhausted configures Recorder.Flush to return the given results in order, one
+// per call.  Once the results are exhausted each call is reported to exhausted, then the last results are returned.
+func (f_sym47 *FakeRecorder) SetFlushStubSequenceExhausted(exhausted_sym47 CharlatanExhausted, results_sym47 ...RecorderFlushResults) {
+	f_sym47.mutex.Lock()
+	defer f_sym47.mutex.Unlock()
+	var calls_sym47 int
+	f_sym47.charlatanExpectHook("Flush", func(errorf_sym47 func(string, ...interface{})) {
+		if calls_sym47 < len(results_sym47) {
+			errorf_sym47("FakeRecorder.SetFlushStubSequence configured with %d results but Recorder.Flush called %d times", len(results_sym47), calls_sym47)
+		}
+	})
+	f_sym47.FlushHook = func() (n int, err error) {
+		f_sym47.mutex.Lock()
+		call_sym47 := calls_sym47
+		calls_sym47++
+		f_sym47.mutex.Unlock()
+		if call_sym47 >= len(results_sym47) {
+			exhausted_sym47("Recorder.Flush", len(results_sym47))
+			if len(results_sym47) == 0 {
+				return
+			}
+			call_sym47 = len(results_sym47) - 1
+		}
+
+		n = results_sym47[call_sym47].N
+		err = results_sym47[call_sym47].Err
+
+		return
+	}
+}
+
+// SetFlushStubOnCall configures Recorder.Flush to return the given values on the nth call, counting from 1
+// since the fake was created or Reset.  Other calls are passed to the hook.
+func (f_sym48 *FakeRecorder) SetFlushStubOnCall(n_sym48 int, n int, err error) {
+	f_sym48.mutex.Lock()
+	defer f_sym48.mutex.Unlock()
+	if f_sym48.charlatanFlushOnCall == nil {
+		f_sym48.charlatanFlushOnCall = make(map[int]RecorderFlushResults)
+	}
+	f_sym48.charlatanFlushOnCall[n_sym48] = RecorderFlushResults{N: n, Err: err}
+	f_sym48.charlatanExpect(func(errorf_sym48 func(string, ...interface{})) {
+		if f_sym48.charlatanFlushCount < n_sym48 {
+			errorf_sym48("FakeRecorder.SetFlushStubOnCall configured for call %d but Recorder.Flush called %d times", n_sym48, f_sym48.charlatanFlushCount)
+		}
+	})
+}
+
+// SetFlushErrorOnCall configures Recorder.Flush to return err and zero values on the nth call, counting
+// from 1 since the fake was created or Reset.  Other calls are passed to the hook.
+func (f *FakeRecorder) SetFlushErrorOnCall(n int, err error) {
+	f.SetFlushStubOnCall(n, 0, err)
+}
+
+// FlushCalled returns true if FakeRecorder.Flush was called
+func (f *FakeRecorder) FlushCalled() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.FlushCalls) != 0
+}
+
+// AssertFlushCalled calls t.Error if FakeRecorder.Flush was not called
+func (f *FakeRecorder) AssertFlushCalled(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.FlushCalls) == 0 {
+		t.Error("FakeRecorder.Flush not called, expected at least one")
+	}
+}
+
+// FlushNotCalled returns true if FakeRecorder.Flush was not called
+func (f *FakeRecorder) FlushNotCalled() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.FlushCalls) == 0
+}
+
+// AssertFlushNotCalled calls t.Error if FakeRecorder.Flush was called
+func (f *FakeRecorder) AssertFlushNotCalled(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.FlushCalls) != 0 {
+		t.Error("FakeRecorder.Flush called, expected none")
+	}
+}
+
+// FlushCalledOnce returns true if FakeRecorder.Flush was called exactly once
+func (f *FakeRecorder) FlushCalledOnce() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.FlushCalls) == 1
+}
+
+// AssertFlushCalledOnce calls t.Error if FakeRecorder.Flush was not called exactly once
+func (f *FakeRecorder) AssertFlushCalledOnce(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.FlushCalls) != 1 {
+		t.Errorf("FakeRecorder.Flush called %d times, expected 1", len(f.FlushCalls))
+	}
+}
+
+// FlushCalledN returns true if FakeRecorder.Flush was called at least n times
+func (f *FakeRecorder) FlushCalledN(n int) bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.FlushCalls) >= n
+}
+
+// AssertFlushCalledN calls t.Error if FakeRecorder.Flush was called less than n times
+func (f *FakeRecorder) AssertFlushCalledN(t RecorderTestingT, n int) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.FlushCalls) < n {
+		t.Errorf("FakeRecorder.Flush called %d times, expected >= %d", len(f.FlushCalls), n)
+	}
+}
+
+func (f_sym49 *FakeRecorder) Convert(value interface{}) (ident1 interface{}) {
+	f_sym49.mutex.Lock()
+	hook_sym49 := f_sym49.ConvertHook
+	f_sym49.charlatanConvertCount++
+	results_sym49, onCall_sym49 := f_sym49.charlatanConvertOnCall[f_sym49.charlatanConvertCount]
+	if hook_sym49 == nil && !onCall_sym49 {
+		f_sym49.mutex.Unlock()
+		panic("Recorder.Convert() called but FakeRecorder.ConvertHook is nil")
+	}
+
+	invocation_sym49 := new(RecorderConvertInvocation)
+	invocation_sym49.Sequence = charlatanNextCall()
+	f_sym49.ConvertCalls = append(f_sym49.ConvertCalls, invocation_sym49)
+
+	invocation_sym49.Parameters.Value = value
+
+	f_sym49.mutex.Unlock()
+
+	if onCall_sym49 {
+		ident1 = results_sym49.Ident1
+	} else {
+		ident1 = hook_sym49(value)
+	}
+
+	f_sym49.mutex.Lock()
+	invocation_sym49.Results.Ident1 = ident1
+	f_sym49.mutex.Unlock()
+
+	return
+}
+
+// ConvertCallsSnapshot returns a copy of the calls of FakeRecorder.Convert, which can be inspected while the fake is in use
+func (f_sym50 *FakeRecorder) ConvertCallsSnapshot() []*RecorderConvertInvocation {
+	f_sym50.mutex.RLock()
+	defer f_sym50.mutex.RUnlock()
+
+	calls_sym50 := make([]*RecorderConvertInvocation, len(f_sym50.ConvertCalls))
+	for i_sym50, call_sym50 := range f_sym50.ConvertCalls {
+		snapshot_sym50 := *call_sym50
+		calls_sym50[i_sym50] = &snapshot_sym50
+	}
+
+	return calls_sym50
+}
+
+// SetConvertStub configures Recorder.Convert to always return the given values
+func (f_sym51 *FakeRecorder) SetConvertStub(ident1 interface{}) {
+	f_sym51.mutex.Lock()
+	defer f_sym51.mutex.Unlock()
+	var used_sym51 bool
+	f_sym51.charlatanExpectHook("Convert", func(errorf_sym51 func(string, ...interface{})) {
+		if !used_sym51 {
+			errorf_sym51("FakeRecorder.SetConvertStub configured but Recorder.Convert not called")
+		}
+	})
+	f_sym51.ConvertHook = func(interface{}) interface{} {
+		f_sym51.mutex.Lock()
+		used_sym51 = true
+		f_sym51.mutex.Unlock()
+		return ident1
+	}
+}
+
+// RecorderConvertResults holds the results of a call of FakeRecorder.Convert, see SetConvertStubSequence
+type RecorderConvertResults struct {
+	Ident1 interface{}
+}
+
+// SetConvertStubSequence configures Recorder.Convert to return the given results in order, one per call.
+// Once the results are exhausted the last results are returned again.
+func (f *FakeRecorder) SetConvertStubSequence(results ...RecorderConvertResults) {
+	f.SetConvertStubSequenceExhausted(CharlatanRepeatLast(), results...)
+}
+
+// SetConvertStubSequenceExhausted configures Recorder.Convert to return the given results in order, one
+// per call.  Once the results are exhausted each call is reported to exhausted, then the last results are returned.
+func (f_sym52 *FakeRecorder) SetConvertStubSequenceExhausted(exhausted_sym52 CharlatanExhausted, results_sym52 ...RecorderConvertResults) {
+	f_sym52.mutex.Lock()
+	defer f_sym52.mutex.Unlock()
+	var calls_sym52 int
+	f_sym52.charlatanExpectHook("Convert", func(errorf_sym52 func(string, ...interface{})) {
+		if calls_sym52 < len(results_sym52) {
+			errorf_sym52("FakeRecorder.SetConvertStubSequence configured with %d results but Recorder.Convert called %d times", len(results_sym52), calls_sym52)
+		}
+	})
+	f_sym52.ConvertHook = func(interface{}) (ident1 interface{}) {
+		f_sym52.mutex.Lock()
+		call_sym52 := calls_sym52
+		calls_sym52++
+		f_sym52.mutex.Unlock()
+		if call_sym52 >= len(results_sym52) {
+			exhausted_sym52("Recorder.Convert", len(results_sym52))
+			if len(results_sym52) == 0 {
+				return
+			}
+			call_sym52 = len(results_sym52) - 1
+		}
+
+		ident1 = results_sym52[call_sym52].Ident1
+
+		return
+	}
+}
+
+// SetConvertStubOnCall configures Recorder.Convert to return the given values on the nth call, counting from 1
+// since the fake was created or Reset.  Other calls are passed to the hook.
+func (f_sym53 *FakeRecorder) SetConvertStubOnCall(n_sym53 int, ident1 interface{}) {
+	f_sym53.mutex.Lock()
+	defer f_sym53.mutex.Unlock()
+	if f_sym53.charlatanConvertOnCall == nil {
+		f_sym53.charlatanConvertOnCall = make(map[int]RecorderConvertResults)
+	}
+	f_sym53.charlatanConvertOnCall[n_sym53] = RecorderConvertResults{Ident1: ident1}
+	f_sym53.charlatanExpect(func(errorf_sym53 func(string, ...interface{})) {
+		if f_sym53.charlatanConvertCount < n_sym53 {
+			errorf_sym53("FakeRecorder.SetConvertStubOnCall configured for call %d but Recorder.Convert called %d times", n_sym53, f_sym53.charlatanConvertCount)
+		}
+	})
+}
+
+// SetConvertInvocation configures Recorder.Convert to return the given results when called with the given parameters
+// If no match is found for an invocation the result(s) of the fallback function are returned
+func (f_sym54 *FakeRecorder) SetConvertInvocation(calls_sym54 []*RecorderConvertInvocation, fallback_sym54 func() interface{}) {
+	f_sym54.mutex.Lock()
+	defer f_sym54.mutex.Unlock()
+	matched_sym54 := make([]bool, len(calls_sym54))
+	f_sym54.charlatanExpectHook("Convert", func(errorf_sym54 func(string, ...interface{})) {
+		for i_sym54, call_sym54 := range calls_sym54 {
+			if !matched_sym54[i_sym54] {
+				errorf_sym54("FakeRecorder.SetConvertInvocation configured with %+v but Recorder.Convert not called with those parameters", call_sym54.Parameters)
+			}
+		}
+	})
+	f_sym54.ConvertHook = func(value interface{}) (ident1 interface{}) {
+		for i_sym54, call_sym54 := range calls_sym54 {
+			if reflect.DeepEqual(call_sym54.Parameters.Value, value) {
+				f_sym54.mutex.Lock()
+				matched_sym54[i_sym54] = true
+				f_sym54.mutex.Unlock()
+				ident1 = call_sym54.Results.Ident1
+
+				return
+			}
+		}
+
+		return fallback_sym54()
+	}
+}
+
+// SetConvertInvocationMatch configures Recorder.Convert to return the given results when its parameters
+// are matched by the given matchers, one per parameter.  Other calls are passed to the previously configured hook.
+func (f_sym55 *FakeRecorder) SetConvertInvocationMatch(value CharlatanMatcher, ident1 interface{}) {
+	f_sym55.mutex.Lock()
+	defer f_sym55.mutex.Unlock()
+	matchers_sym55 := []CharlatanMatcher{value}
+	previous_sym55 := f_sym55.ConvertHook
+	var used_sym55 bool
+	expected_sym55 := f_sym55.hookExpectations["Convert"]
+	f_sym55.charlatanExpectHook("Convert", func(errorf_sym55 func(string, ...interface{})) {
+		// N.B. - the previous hook still receives the unmatched calls
+		if expected_sym55 != nil {
+			expected_sym55(errorf_sym55)
+		}
+		if !used_sym55 {
+			errorf_sym55("FakeRecorder.SetConvertInvocationMatch configured but Recorder.Convert not called with matching parameters")
+		}
+	})
+	f_sym55.ConvertHook = func(value interface{}) interface{} {
+		if matchers_sym55[0].Match(value) {
+			f_sym55.mutex.Lock()
+			used_sym55 = true
+			f_sym55.mutex.Unlock()
+			return ident1
+		}
+		if previous_sym55 == nil {
+			panic("Recorder.Convert() called with unmatched parameters but FakeRecorder.ConvertHook has no previous hook")
+		}
+
+		return previous_sym55(value)
+	}
+}
+
+// ConvertCalled returns true if FakeRecorder.Convert was called
+func (f *FakeRecorder) ConvertCalled() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.ConvertCalls) != 0
+}
+
+// AssertConvertCalled calls t.Error if FakeRecorder.Convert was not called
+func (f *FakeRecorder) AssertConvertCalled(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.ConvertCalls) == 0 {
+		t.Error("FakeRecorder.Convert not called, expected at least one")
+	}
+}
+
+// ConvertNotCalled returns true if FakeRecorder.Convert was not called
+func (f *FakeRecorder) ConvertNotCalled() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.ConvertCalls) == 0
+}
+
+// AssertConvertNotCalled calls t.Error if FakeRecorder.Convert was called
+func (f *FakeRecorder) AssertConvertNotCalled(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.ConvertCalls) != 0 {
+		t.Error("FakeRecorder.Convert called, expected none")
+	}
+}
+
+// ConvertCalledOnce returns true if FakeRecorder.Convert was called exactly once
+func (f *FakeRecorder) ConvertCalledOnce() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.ConvertCalls) == 1
+}
+
+// AssertConvertCalledOnce calls t.Error if FakeRecorder.Convert was not called exactly once
+func (f *FakeRecorder) AssertConvertCalledOnce(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.ConvertCalls) != 1 {
+		t.Errorf("FakeRecorder.Convert called %d times, expected 1", len(f.ConvertCalls))
+	}
+}
+
+// ConvertCalledN returns true if FakeRecorder.Convert was called at least n times
+func (f *FakeRecorder) ConvertCalledN(n int) bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.ConvertCalls) >= n
+}
+
+// AssertConvertCalledN calls t.Error if FakeRecorder.Convert was called less than n times
+func (f *FakeRecorder) AssertConvertCalledN(t RecorderTestingT, n int) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.ConvertCalls) < n {
+		t.Errorf("FakeRecorder.Convert called %d times, expected >= %d", len(f.ConvertCalls), n)
+	}
+}
+
+// ConvertCalledWith returns true if FakeRecorder.Convert was called with the given values
+func (f_sym56 *FakeRecorder) ConvertCalledWith(value interface{}) bool {
+	f_sym56.mutex.RLock()
+	defer f_sym56.mutex.RUnlock()
+	for _, call_sym56 := range f_sym56.ConvertCalls {
+		if reflect.DeepEqual(call_sym56.Parameters.Value, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertConvertCalledWith calls t.Error if FakeRecorder.Convert was not called with the given values
+func (f_sym57 *FakeRecorder) AssertConvertCalledWith(t RecorderTestingT, value interface{}) {
+	t.Helper()
+	f_sym57.mutex.RLock()
+	defer f_sym57.mutex.RUnlock()
+	var found_sym57 bool
+	for _, call_sym57 := range f_sym57.ConvertCalls {
+		if reflect.DeepEqual(call_sym57.Parameters.Value, value) {
+			found_sym57 = true
+			break
+		}
+	}
+
+	if !found_sym57 {
+		t.Error("FakeRecorder.Convert not called with expected parameters")
+	}
+}
+
+// ConvertCalledWithMatch returns true if FakeRecorder.Convert was called with parameters matched by the given matchers, one per parameter
+func (f_sym58 *FakeRecorder) ConvertCalledWithMatch(value CharlatanMatcher) bool {
+	f_sym58.mutex.RLock()
+	defer f_sym58.mutex.RUnlock()
+	for _, call_sym58 := range f_sym58.ConvertCalls {
+		if value.Match(call_sym58.Parameters.Value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertConvertCalledWithMatch calls t.Error if FakeRecorder.Convert was not called with parameters matched by the given matchers, one per parameter
+func (f_sym59 *FakeRecorder) AssertConvertCalledWithMatch(t RecorderTestingT, value CharlatanMatcher) {
+	t.Helper()
+	f_sym59.mutex.RLock()
+	defer f_sym59.mutex.RUnlock()
+	for _, call_sym59 := range f_sym59.ConvertCalls {
+		if value.Match(call_sym59.Parameters.Value) {
+			return
+		}
+	}
+
+	t.Error("FakeRecorder.Convert not called with matching parameters")
+}
+
+// ConvertCalledOnceWith returns true if FakeRecorder.Convert was called exactly once with the given values
+func (f_sym60 *FakeRecorder) ConvertCalledOnceWith(value interface{}) bool {
+	f_sym60.mutex.RLock()
+	defer f_sym60.mutex.RUnlock()
+	var count_sym60 int
+	for _, call_sym60 := range f_sym60.ConvertCalls {
+		if reflect.DeepEqual(call_sym60.Parameters.Value, value) {
+			count_sym60++
+		}
+	}
+
+	return count_sym60 == 1
+}
+
+// AssertConvertCalledOnceWith calls t.Error if FakeRecorder.Convert was not called exactly once with the given values
+func (f_sym61 *FakeRecorder) AssertConvertCalledOnceWith(t RecorderTestingT, value interface{}) {
+	t.Helper()
+	f_sym61.mutex.RLock()
+	defer f_sym61.mutex.RUnlock()
+	var count_sym61 int
+	for _, call_sym61 := range f_sym61.ConvertCalls {
+		if reflect.DeepEqual(call_sym61.Parameters.Value, value) {
+			count_sym61++
+		}
+	}
+
+	if count_sym61 != 1 {
+		t.Errorf("FakeRecorder.Convert called %d times with expected parameters, expected one", count_sym61)
+	}
+}
+
+// ConvertResultsForCall returns the result values for the first call to FakeRecorder.Convert with the given values
+func (f_sym62 *FakeRecorder) ConvertResultsForCall(value interface{}) (ident1 interface{}, found_sym62 bool) {
+	f_sym62.mutex.RLock()
+	defer f_sym62.mutex.RUnlock()
+	for _, call_sym62 := range f_sym62.ConvertCalls {
+		if reflect.DeepEqual(call_sym62.Parameters.Value, value) {
+			ident1 = call_sym62.Results.Ident1
+			found_sym62 = true
+			break
+		}
+	}
+
+	return
+}
+
+func (f_sym63 *FakeRecorder) Close() {
+	f_sym63.mutex.Lock()
+	hook_sym63 := f_sym63.CloseHook
+	if hook_sym63 == nil {
+		f_sym63.mutex.Unlock()
+		panic("Recorder.Close() called but FakeRecorder.CloseHook is nil")
+	}
+
+	invocation_sym63 := new(RecorderCloseInvocation)
+	invocation_sym63.Sequence = charlatanNextCall()
+	f_sym63.CloseCalls = append(f_sym63.CloseCalls, invocation_sym63)
+
+	f_sym63.mutex.Unlock()
+
+	hook_sym63()
+
+	return
+}
+
+// CloseCallsSnapshot returns a copy of the calls of FakeRecorder.Close, which can be inspected while the fake is in use
+func (f_sym64 *FakeRecorder) CloseCallsSnapshot() []*RecorderCloseInvocation {
+	f_sym64.mutex.RLock()
+	defer f_sym64.mutex.RUnlock()
+
+	calls_sym64 := make([]*RecorderCloseInvocation, len(f_sym64.CloseCalls))
+	for i_sym64, call_sym64 := range f_sym64.CloseCalls {
+		snapshot_sym64 := *call_sym64
+		calls_sym64[i_sym64] = &snapshot_sym64
+	}
+
+	return calls_sym64
+}
+
+// CloseCalled returns true if FakeRecorder.Close was called
+func (f *FakeRecorder) CloseCalled() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.CloseCalls) != 0
+}
+
+// AssertCloseCalled calls t.Error if FakeRecorder.Close was not called
+func (f *FakeRecorder) AssertCloseCalled(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.CloseCalls) == 0 {
+		t.Error("FakeRecorder.Close not called, expected at least one")
+	}
+}
+
+// CloseNotCalled returns true if FakeRecorder.Close was not called
+func (f *FakeRecorder) CloseNotCalled() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.CloseCalls) == 0
+}
+
+// AssertCloseNotCalled calls t.Error if FakeRecorder.Close was called
+func (f *FakeRecorder) AssertCloseNotCalled(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.CloseCalls) != 0 {
+		t.Error("FakeRecorder.Close called, expected none")
+	}
+}
+
+// CloseCalledOnce returns true if FakeRecorder.Close was called exactly once
+func (f *FakeRecorder) CloseCalledOnce() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.CloseCalls) == 1
+}
+
+// AssertCloseCalledOnce calls t.Error if FakeRecorder.Close was not called exactly once
+func (f *FakeRecorder) AssertCloseCalledOnce(t RecorderTestingT) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.CloseCalls) != 1 {
+		t.Errorf("FakeRecorder.Close called %d times, expected 1", len(f.CloseCalls))
+	}
+}
+
+// CloseCalledN returns true if FakeRecorder.Close was called at least n times
+func (f *FakeRecorder) CloseCalledN(n int) bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return len(f.CloseCalls) >= n
+}
+
+// AssertCloseCalledN calls t.Error if FakeRecorder.Close was called less than n times
+func (f *FakeRecorder) AssertCloseCalledN(t RecorderTestingT, n int) {
+	t.Helper()
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if len(f.CloseCalls) < n {
+		t.Errorf("FakeRecorder.Close called %d times, expected >= %d", len(f.CloseCalls), n)
+	}
+}
+
+// AssertCallOrder calls t.Error if the named methods of FakeRecorder were not called in the given order, other
+// calls may come between them.  The error reports the order of all the calls of FakeRecorder.
+func (f_sym65 *FakeRecorder) AssertCallOrder(t RecorderTestingT, methods_sym65 ...string) {
+	t.Helper()
+	f_sym65.mutex.RLock()
+	defer f_sym65.mutex.RUnlock()
+	var calls_sym65 []CharlatanCall
+	for _, call_sym65 := range f_sym65.LookupCalls {
+		calls_sym65 = append(calls_sym65, call_sym65)
+	}
+	for _, call_sym65 := range f_sym65.PutCalls {
+		calls_sym65 = append(calls_sym65, call_sym65)
+	}
+	for _, call_sym65 := range f_sym65.FlushCalls {
+		calls_sym65 = append(calls_sym65, call_sym65)
+	}
+	for _, call_sym65 := range f_sym65.ConvertCalls {
+		calls_sym65 = append(calls_sym65, call_sym65)
+	}
+	for _, call_sym65 := range f_sym65.CloseCalls {
+		calls_sym65 = append(calls_sym65, call_sym65)
+	}
+	calls_sym65 = charlatanSortCalls(calls_sym65)
+
+	next_sym65 := 0
+	for _, call_sym65 := range calls_sym65 {
+		if next_sym65 < len(methods_sym65) && call_sym65.CallName() == "FakeRecorder."+methods_sym65[next_sym65] {
+			next_sym65++
+		}
+	}
+
+	if next_sym65 != len(methods_sym65) {
+		t.Errorf("FakeRecorder methods not called in the order %q, actual order: %s", methods_sym65, charlatanCallNames(calls_sym65))
+	}
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+type Item struct {
+	Name  string `json:"name"`
+	Count int
+	Tags  []string `json:"tags,omitempty"`
+}
+
+type Recorder interface {
+	Lookup(ctx context.Context, key string, at time.Time) (item *Item, found bool, err error)
+	Put(items map[string]Item, data []byte) error
+	Flush() (n int, err error)
+	Convert(value interface{}) interface{}
+	Close()
+}
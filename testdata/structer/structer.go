@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/structer -output=testdata/structer/structer.go Structer".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/structer -output=testdata/structer/structer.go Structer".  DO NOT EDIT.
 
 package main
 
@@ -82,17 +82,19 @@ type StructerTestingT interface {
 FakeStructer is a mock implementation of Structer for testing.
 Use it in your tests as in this example:
 
-	package example
+		package example
+
+		func TestWithStructer(t *testing.T) {
+			f := &main.FakeStructer{
+				StructHook: func(ident1 struct {
+		a string
+		b string
+	}) (ident2 struct {
+
+		c string
+		d string
+	}) {
 
-	func TestWithStructer(t *testing.T) {
-		f := &main.FakeStructer{
-			StructHook: func(ident1 struct {
-	a string
-	b string
-}) (ident2 struct {
-	c string
-	d string
-}) {
 				// ensure parameters meet expectations, signal errors using t, etc
 				return
 			},
@@ -100,13 +102,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeStruct ...
+		// assert state of FakeStructer ...
 		f.AssertStructCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeStruct.
+unexpected calls are made to FakeStructer.
 */
 type FakeStructer struct {
 	StructHook func(struct {
@@ -128,6 +130,8 @@ type FakeStructer struct {
 	NamedStructCalls []*StructerNamedStructInvocation
 }
 
+var _ Structer = (*FakeStructer)(nil)
+
 // NewFakeStructerDefaultPanic returns an instance of FakeStructer with all hooks configured to panic
 func NewFakeStructerDefaultPanic() *FakeStructer {
 	return &FakeStructer{
@@ -204,40 +208,98 @@ func NewFakeStructerDefaultError(t_sym2 StructerTestingT) *FakeStructer {
 	}
 }
 
+// NewFakeStructerDefaultZero returns an instance of FakeStructer with all hooks configured to return zero values
+func NewFakeStructerDefaultZero() *FakeStructer {
+	return &FakeStructer{
+		StructHook: func(struct {
+			a string
+			b string
+		}) (ident2 struct {
+			c string
+			d string
+		}) {
+			return
+		},
+		NamedStructHook: func(struct {
+			a string
+			b string
+		}) (z struct {
+			c string
+			d string
+		}) {
+			return
+		},
+	}
+}
+
+// NewFakeStructerDefaultErr returns an instance of FakeStructer with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeStructerDefaultErr(err_sym3 error) *FakeStructer {
+	return &FakeStructer{
+		StructHook: func(struct {
+			a string
+			b string
+		}) (ident2 struct {
+			c string
+			d string
+		}) {
+			return
+		},
+		NamedStructHook: func(struct {
+			a string
+			b string
+		}) (z struct {
+			c string
+			d string
+		}) {
+			return
+		},
+	}
+}
+
+// NewFakeStructerSpy returns an instance of FakeStructer with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeStructerSpy(real_sym4 Structer) *FakeStructer {
+	return &FakeStructer{
+		StructHook:      real_sym4.Struct,
+		NamedStructHook: real_sym4.NamedStruct,
+	}
+}
+
 func (f *FakeStructer) Reset() {
 	f.StructCalls = []*StructerStructInvocation{}
 	f.NamedStructCalls = []*StructerNamedStructInvocation{}
 }
 
-func (f_sym3 *FakeStructer) Struct(ident1 struct {
+func (f_sym5 *FakeStructer) Struct(ident1 struct {
 	a string
 	b string
 }) (ident2 struct {
 	c string
 	d string
 }) {
-	if f_sym3.StructHook == nil {
+	if f_sym5.StructHook == nil {
 		panic("Structer.Struct() called but FakeStructer.StructHook is nil")
 	}
 
-	invocation_sym3 := new(StructerStructInvocation)
-	f_sym3.StructCalls = append(f_sym3.StructCalls, invocation_sym3)
+	invocation_sym5 := new(StructerStructInvocation)
+	f_sym5.StructCalls = append(f_sym5.StructCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation_sym5.Parameters.Ident1 = ident1
 
-	ident2 = f_sym3.StructHook(ident1)
+	ident2 = f_sym5.StructHook(ident1)
 
-	invocation_sym3.Results.Ident2 = ident2
+	invocation_sym5.Results.Ident2 = ident2
 
 	return
 }
 
 // SetStructStub configures Structer.Struct to always return the given values
-func (f_sym4 *FakeStructer) SetStructStub(ident2 struct {
+func (f_sym6 *FakeStructer) SetStructStub(ident2 struct {
 	c string
 	d string
 }) {
-	f_sym4.StructHook = func(struct {
+	f_sym6.StructHook = func(struct {
 		a string
 		b string
 	}) struct {
@@ -250,26 +312,26 @@ func (f_sym4 *FakeStructer) SetStructStub(ident2 struct {
 
 // SetStructInvocation configures Structer.Struct to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeStructer) SetStructInvocation(calls_sym5 []*StructerStructInvocation, fallback_sym5 func() struct {
+func (f_sym7 *FakeStructer) SetStructInvocation(calls_sym7 []*StructerStructInvocation, fallback_sym7 func() struct {
 	c string
 	d string
 }) {
-	f_sym5.StructHook = func(ident1 struct {
+	f_sym7.StructHook = func(ident1 struct {
 		a string
 		b string
 	}) (ident2 struct {
 		c string
 		d string
 	}) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+		for _, call_sym7 := range calls_sym7 {
+			if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
+				ident2 = call_sym7.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback_sym7()
 	}
 }
 
@@ -326,12 +388,12 @@ func (f *FakeStructer) AssertStructCalledN(t StructerTestingT, n int) {
 }
 
 // StructCalledWith returns true if FakeStructer.Struct was called with the given values
-func (f_sym6 *FakeStructer) StructCalledWith(ident1 struct {
+func (f_sym8 *FakeStructer) StructCalledWith(ident1 struct {
 	a string
 	b string
 }) bool {
-	for _, call_sym6 := range f_sym6.StructCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+	for _, call_sym8 := range f_sym8.StructCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
 			return true
 		}
 	}
@@ -340,69 +402,69 @@ func (f_sym6 *FakeStructer) StructCalledWith(ident1 struct {
 }
 
 // AssertStructCalledWith calls t.Error if FakeStructer.Struct was not called with the given values
-func (f_sym7 *FakeStructer) AssertStructCalledWith(t StructerTestingT, ident1 struct {
+func (f_sym9 *FakeStructer) AssertStructCalledWith(t StructerTestingT, ident1 struct {
 	a string
 	b string
 }) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.StructCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	var found_sym9 bool
+	for _, call_sym9 := range f_sym9.StructCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
+			found_sym9 = true
 			break
 		}
 	}
 
-	if !found_sym7 {
+	if !found_sym9 {
 		t.Error("FakeStructer.Struct not called with expected parameters")
 	}
 }
 
 // StructCalledOnceWith returns true if FakeStructer.Struct was called exactly once with the given values
-func (f_sym8 *FakeStructer) StructCalledOnceWith(ident1 struct {
+func (f_sym10 *FakeStructer) StructCalledOnceWith(ident1 struct {
 	a string
 	b string
 }) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.StructCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+	var count_sym10 int
+	for _, call_sym10 := range f_sym10.StructCalls {
+		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
+			count_sym10++
 		}
 	}
 
-	return count_sym8 == 1
+	return count_sym10 == 1
 }
 
 // AssertStructCalledOnceWith calls t.Error if FakeStructer.Struct was not called exactly once with the given values
-func (f_sym9 *FakeStructer) AssertStructCalledOnceWith(t StructerTestingT, ident1 struct {
+func (f_sym11 *FakeStructer) AssertStructCalledOnceWith(t StructerTestingT, ident1 struct {
 	a string
 	b string
 }) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.StructCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	var count_sym11 int
+	for _, call_sym11 := range f_sym11.StructCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.Ident1, ident1) {
+			count_sym11++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeStructer.Struct called %d times with expected parameters, expected one", count_sym9)
+	if count_sym11 != 1 {
+		t.Errorf("FakeStructer.Struct called %d times with expected parameters, expected one", count_sym11)
 	}
 }
 
 // StructResultsForCall returns the result values for the first call to FakeStructer.Struct with the given values
-func (f_sym10 *FakeStructer) StructResultsForCall(ident1 struct {
+func (f_sym12 *FakeStructer) StructResultsForCall(ident1 struct {
 	a string
 	b string
 }) (ident2 struct {
 	c string
 	d string
-}, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.StructCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+}, found_sym12 bool) {
+	for _, call_sym12 := range f_sym12.StructCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.Ident1, ident1) {
+			ident2 = call_sym12.Results.Ident2
+			found_sym12 = true
 			break
 		}
 	}
@@ -410,35 +472,35 @@ func (f_sym10 *FakeStructer) StructResultsForCall(ident1 struct {
 	return
 }
 
-func (f_sym11 *FakeStructer) NamedStruct(a struct {
+func (f_sym13 *FakeStructer) NamedStruct(a struct {
 	a string
 	b string
 }) (z struct {
 	c string
 	d string
 }) {
-	if f_sym11.NamedStructHook == nil {
+	if f_sym13.NamedStructHook == nil {
 		panic("Structer.NamedStruct() called but FakeStructer.NamedStructHook is nil")
 	}
 
-	invocation_sym11 := new(StructerNamedStructInvocation)
-	f_sym11.NamedStructCalls = append(f_sym11.NamedStructCalls, invocation_sym11)
+	invocation_sym13 := new(StructerNamedStructInvocation)
+	f_sym13.NamedStructCalls = append(f_sym13.NamedStructCalls, invocation_sym13)
 
-	invocation_sym11.Parameters.A = a
+	invocation_sym13.Parameters.A = a
 
-	z = f_sym11.NamedStructHook(a)
+	z = f_sym13.NamedStructHook(a)
 
-	invocation_sym11.Results.Z = z
+	invocation_sym13.Results.Z = z
 
 	return
 }
 
 // SetNamedStructStub configures Structer.NamedStruct to always return the given values
-func (f_sym12 *FakeStructer) SetNamedStructStub(z struct {
+func (f_sym14 *FakeStructer) SetNamedStructStub(z struct {
 	c string
 	d string
 }) {
-	f_sym12.NamedStructHook = func(struct {
+	f_sym14.NamedStructHook = func(struct {
 		a string
 		b string
 	}) struct {
@@ -451,26 +513,26 @@ func (f_sym12 *FakeStructer) SetNamedStructStub(z struct {
 
 // SetNamedStructInvocation configures Structer.NamedStruct to return the given results when called with the given parameters
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeStructer) SetNamedStructInvocation(calls_sym13 []*StructerNamedStructInvocation, fallback_sym13 func() struct {
+func (f_sym15 *FakeStructer) SetNamedStructInvocation(calls_sym15 []*StructerNamedStructInvocation, fallback_sym15 func() struct {
 	c string
 	d string
 }) {
-	f_sym13.NamedStructHook = func(a struct {
+	f_sym15.NamedStructHook = func(a struct {
 		a string
 		b string
 	}) (z struct {
 		c string
 		d string
 	}) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.A, a) {
-				z = call_sym13.Results.Z
+		for _, call_sym15 := range calls_sym15 {
+			if reflect.DeepEqual(call_sym15.Parameters.A, a) {
+				z = call_sym15.Results.Z
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback_sym15()
 	}
 }
 
@@ -527,12 +589,12 @@ func (f *FakeStructer) AssertNamedStructCalledN(t StructerTestingT, n int) {
 }
 
 // NamedStructCalledWith returns true if FakeStructer.NamedStruct was called with the given values
-func (f_sym14 *FakeStructer) NamedStructCalledWith(a struct {
+func (f_sym16 *FakeStructer) NamedStructCalledWith(a struct {
 	a string
 	b string
 }) bool {
-	for _, call_sym14 := range f_sym14.NamedStructCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.A, a) {
+	for _, call_sym16 := range f_sym16.NamedStructCalls {
+		if reflect.DeepEqual(call_sym16.Parameters.A, a) {
 			return true
 		}
 	}
@@ -541,69 +603,69 @@ func (f_sym14 *FakeStructer) NamedStructCalledWith(a struct {
 }
 
 // AssertNamedStructCalledWith calls t.Error if FakeStructer.NamedStruct was not called with the given values
-func (f_sym15 *FakeStructer) AssertNamedStructCalledWith(t StructerTestingT, a struct {
+func (f_sym17 *FakeStructer) AssertNamedStructCalledWith(t StructerTestingT, a struct {
 	a string
 	b string
 }) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.NamedStructCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.A, a) {
-			found_sym15 = true
+	var found_sym17 bool
+	for _, call_sym17 := range f_sym17.NamedStructCalls {
+		if reflect.DeepEqual(call_sym17.Parameters.A, a) {
+			found_sym17 = true
 			break
 		}
 	}
 
-	if !found_sym15 {
+	if !found_sym17 {
 		t.Error("FakeStructer.NamedStruct not called with expected parameters")
 	}
 }
 
 // NamedStructCalledOnceWith returns true if FakeStructer.NamedStruct was called exactly once with the given values
-func (f_sym16 *FakeStructer) NamedStructCalledOnceWith(a struct {
+func (f_sym18 *FakeStructer) NamedStructCalledOnceWith(a struct {
 	a string
 	b string
 }) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.NamedStructCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.A, a) {
-			count_sym16++
+	var count_sym18 int
+	for _, call_sym18 := range f_sym18.NamedStructCalls {
+		if reflect.DeepEqual(call_sym18.Parameters.A, a) {
+			count_sym18++
 		}
 	}
 
-	return count_sym16 == 1
+	return count_sym18 == 1
 }
 
 // AssertNamedStructCalledOnceWith calls t.Error if FakeStructer.NamedStruct was not called exactly once with the given values
-func (f_sym17 *FakeStructer) AssertNamedStructCalledOnceWith(t StructerTestingT, a struct {
+func (f_sym19 *FakeStructer) AssertNamedStructCalledOnceWith(t StructerTestingT, a struct {
 	a string
 	b string
 }) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.NamedStructCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.A, a) {
-			count_sym17++
+	var count_sym19 int
+	for _, call_sym19 := range f_sym19.NamedStructCalls {
+		if reflect.DeepEqual(call_sym19.Parameters.A, a) {
+			count_sym19++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeStructer.NamedStruct called %d times with expected parameters, expected one", count_sym17)
+	if count_sym19 != 1 {
+		t.Errorf("FakeStructer.NamedStruct called %d times with expected parameters, expected one", count_sym19)
 	}
 }
 
 // NamedStructResultsForCall returns the result values for the first call to FakeStructer.NamedStruct with the given values
-func (f_sym18 *FakeStructer) NamedStructResultsForCall(a struct {
+func (f_sym20 *FakeStructer) NamedStructResultsForCall(a struct {
 	a string
 	b string
 }) (z struct {
 	c string
 	d string
-}, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.NamedStructCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.A, a) {
-			z = call_sym18.Results.Z
-			found_sym18 = true
+}, found_sym20 bool) {
+	for _, call_sym20 := range f_sym20.NamedStructCalls {
+		if reflect.DeepEqual(call_sym20.Parameters.A, a) {
+			z = call_sym20.Results.Z
+			found_sym20 = true
 			break
 		}
 	}
@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/variadic -output=testdata/variadic/variadic.go Variadic".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/variadic -output=testdata/variadic/variadic.go Variadic".  DO NOT EDIT.
 
 package main
 
@@ -45,13 +45,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeSingleVariadic ...
+		// assert state of FakeVariadic ...
 		f.AssertSingleVariadicCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeSingleVariadic.
+unexpected calls are made to FakeVariadic.
 */
 type FakeVariadic struct {
 	SingleVariadicHook func(...string)
@@ -61,6 +61,8 @@ type FakeVariadic struct {
 	MixedVariadicCalls  []*VariadicMixedVariadicInvocation
 }
 
+var _ Variadic = (*FakeVariadic)(nil)
+
 // NewFakeVariadicDefaultPanic returns an instance of FakeVariadic with all hooks configured to panic
 func NewFakeVariadicDefaultPanic() *FakeVariadic {
 	return &FakeVariadic{
@@ -101,22 +103,56 @@ func NewFakeVariadicDefaultError(t_sym2 VariadicTestingT) *FakeVariadic {
 	}
 }
 
+// NewFakeVariadicDefaultZero returns an instance of FakeVariadic with all hooks configured to return zero values
+func NewFakeVariadicDefaultZero() *FakeVariadic {
+	return &FakeVariadic{
+		SingleVariadicHook: func(...string) {
+			return
+		},
+		MixedVariadicHook: func(int, int, int, ...string) {
+			return
+		},
+	}
+}
+
+// NewFakeVariadicDefaultErr returns an instance of FakeVariadic with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeVariadicDefaultErr(err_sym3 error) *FakeVariadic {
+	return &FakeVariadic{
+		SingleVariadicHook: func(...string) {
+			return
+		},
+		MixedVariadicHook: func(int, int, int, ...string) {
+			return
+		},
+	}
+}
+
+// NewFakeVariadicSpy returns an instance of FakeVariadic with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeVariadicSpy(real_sym4 Variadic) *FakeVariadic {
+	return &FakeVariadic{
+		SingleVariadicHook: real_sym4.SingleVariadic,
+		MixedVariadicHook:  real_sym4.MixedVariadic,
+	}
+}
+
 func (f *FakeVariadic) Reset() {
 	f.SingleVariadicCalls = []*VariadicSingleVariadicInvocation{}
 	f.MixedVariadicCalls = []*VariadicMixedVariadicInvocation{}
 }
 
-func (f_sym3 *FakeVariadic) SingleVariadic(a ...string) {
-	if f_sym3.SingleVariadicHook == nil {
+func (f_sym5 *FakeVariadic) SingleVariadic(a ...string) {
+	if f_sym5.SingleVariadicHook == nil {
 		panic("Variadic.SingleVariadic() called but FakeVariadic.SingleVariadicHook is nil")
 	}
 
-	invocation_sym3 := new(VariadicSingleVariadicInvocation)
-	f_sym3.SingleVariadicCalls = append(f_sym3.SingleVariadicCalls, invocation_sym3)
+	invocation_sym5 := new(VariadicSingleVariadicInvocation)
+	f_sym5.SingleVariadicCalls = append(f_sym5.SingleVariadicCalls, invocation_sym5)
 
-	invocation_sym3.Parameters.A = a
+	invocation_sym5.Parameters.A = a
 
-	f_sym3.SingleVariadicHook(a...)
+	f_sym5.SingleVariadicHook(a...)
 
 	return
 }
@@ -174,9 +210,9 @@ func (f *FakeVariadic) AssertSingleVariadicCalledN(t VariadicTestingT, n int) {
 }
 
 // SingleVariadicCalledWith returns true if FakeVariadic.SingleVariadic was called with the given values
-func (f_sym4 *FakeVariadic) SingleVariadicCalledWith(a ...string) bool {
-	for _, call_sym4 := range f_sym4.SingleVariadicCalls {
-		if reflect.DeepEqual(call_sym4.Parameters.A, a) {
+func (f_sym6 *FakeVariadic) SingleVariadicCalledWith(a ...string) bool {
+	for _, call_sym6 := range f_sym6.SingleVariadicCalls {
+		if reflect.DeepEqual(call_sym6.Parameters.A, a) {
 			return true
 		}
 	}
@@ -185,62 +221,62 @@ func (f_sym4 *FakeVariadic) SingleVariadicCalledWith(a ...string) bool {
 }
 
 // AssertSingleVariadicCalledWith calls t.Error if FakeVariadic.SingleVariadic was not called with the given values
-func (f_sym5 *FakeVariadic) AssertSingleVariadicCalledWith(t VariadicTestingT, a ...string) {
+func (f_sym7 *FakeVariadic) AssertSingleVariadicCalledWith(t VariadicTestingT, a ...string) {
 	t.Helper()
-	var found_sym5 bool
-	for _, call_sym5 := range f_sym5.SingleVariadicCalls {
-		if reflect.DeepEqual(call_sym5.Parameters.A, a) {
-			found_sym5 = true
+	var found_sym7 bool
+	for _, call_sym7 := range f_sym7.SingleVariadicCalls {
+		if reflect.DeepEqual(call_sym7.Parameters.A, a) {
+			found_sym7 = true
 			break
 		}
 	}
 
-	if !found_sym5 {
+	if !found_sym7 {
 		t.Error("FakeVariadic.SingleVariadic not called with expected parameters")
 	}
 }
 
 // SingleVariadicCalledOnceWith returns true if FakeVariadic.SingleVariadic was called exactly once with the given values
-func (f_sym6 *FakeVariadic) SingleVariadicCalledOnceWith(a ...string) bool {
-	var count_sym6 int
-	for _, call_sym6 := range f_sym6.SingleVariadicCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.A, a) {
-			count_sym6++
+func (f_sym8 *FakeVariadic) SingleVariadicCalledOnceWith(a ...string) bool {
+	var count_sym8 int
+	for _, call_sym8 := range f_sym8.SingleVariadicCalls {
+		if reflect.DeepEqual(call_sym8.Parameters.A, a) {
+			count_sym8++
 		}
 	}
 
-	return count_sym6 == 1
+	return count_sym8 == 1
 }
 
 // AssertSingleVariadicCalledOnceWith calls t.Error if FakeVariadic.SingleVariadic was not called exactly once with the given values
-func (f_sym7 *FakeVariadic) AssertSingleVariadicCalledOnceWith(t VariadicTestingT, a ...string) {
+func (f_sym9 *FakeVariadic) AssertSingleVariadicCalledOnceWith(t VariadicTestingT, a ...string) {
 	t.Helper()
-	var count_sym7 int
-	for _, call_sym7 := range f_sym7.SingleVariadicCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.A, a) {
-			count_sym7++
+	var count_sym9 int
+	for _, call_sym9 := range f_sym9.SingleVariadicCalls {
+		if reflect.DeepEqual(call_sym9.Parameters.A, a) {
+			count_sym9++
 		}
 	}
 
-	if count_sym7 != 1 {
-		t.Errorf("FakeVariadic.SingleVariadic called %d times with expected parameters, expected one", count_sym7)
+	if count_sym9 != 1 {
+		t.Errorf("FakeVariadic.SingleVariadic called %d times with expected parameters, expected one", count_sym9)
 	}
 }
 
-func (f_sym8 *FakeVariadic) MixedVariadic(a int, b int, c int, d ...string) {
-	if f_sym8.MixedVariadicHook == nil {
+func (f_sym10 *FakeVariadic) MixedVariadic(a int, b int, c int, d ...string) {
+	if f_sym10.MixedVariadicHook == nil {
 		panic("Variadic.MixedVariadic() called but FakeVariadic.MixedVariadicHook is nil")
 	}
 
-	invocation_sym8 := new(VariadicMixedVariadicInvocation)
-	f_sym8.MixedVariadicCalls = append(f_sym8.MixedVariadicCalls, invocation_sym8)
+	invocation_sym10 := new(VariadicMixedVariadicInvocation)
+	f_sym10.MixedVariadicCalls = append(f_sym10.MixedVariadicCalls, invocation_sym10)
 
-	invocation_sym8.Parameters.A = a
-	invocation_sym8.Parameters.B = b
-	invocation_sym8.Parameters.C = c
-	invocation_sym8.Parameters.D = d
+	invocation_sym10.Parameters.A = a
+	invocation_sym10.Parameters.B = b
+	invocation_sym10.Parameters.C = c
+	invocation_sym10.Parameters.D = d
 
-	f_sym8.MixedVariadicHook(a, b, c, d...)
+	f_sym10.MixedVariadicHook(a, b, c, d...)
 
 	return
 }
@@ -298,9 +334,9 @@ func (f *FakeVariadic) AssertMixedVariadicCalledN(t VariadicTestingT, n int) {
 }
 
 // MixedVariadicCalledWith returns true if FakeVariadic.MixedVariadic was called with the given values
-func (f_sym9 *FakeVariadic) MixedVariadicCalledWith(a int, b int, c int, d ...string) bool {
-	for _, call_sym9 := range f_sym9.MixedVariadicCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.A, a) && reflect.DeepEqual(call_sym9.Parameters.B, b) && reflect.DeepEqual(call_sym9.Parameters.C, c) && reflect.DeepEqual(call_sym9.Parameters.D, d) {
+func (f_sym11 *FakeVariadic) MixedVariadicCalledWith(a int, b int, c int, d ...string) bool {
+	for _, call_sym11 := range f_sym11.MixedVariadicCalls {
+		if reflect.DeepEqual(call_sym11.Parameters.A, a) && reflect.DeepEqual(call_sym11.Parameters.B, b) && reflect.DeepEqual(call_sym11.Parameters.C, c) && reflect.DeepEqual(call_sym11.Parameters.D, d) {
 			return true
 		}
 	}
@@ -309,44 +345,44 @@ func (f_sym9 *FakeVariadic) MixedVariadicCalledWith(a int, b int, c int, d ...st
 }
 
 // AssertMixedVariadicCalledWith calls t.Error if FakeVariadic.MixedVariadic was not called with the given values
-func (f_sym10 *FakeVariadic) AssertMixedVariadicCalledWith(t VariadicTestingT, a int, b int, c int, d ...string) {
+func (f_sym12 *FakeVariadic) AssertMixedVariadicCalledWith(t VariadicTestingT, a int, b int, c int, d ...string) {
 	t.Helper()
-	var found_sym10 bool
-	for _, call_sym10 := range f_sym10.MixedVariadicCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.A, a) && reflect.DeepEqual(call_sym10.Parameters.B, b) && reflect.DeepEqual(call_sym10.Parameters.C, c) && reflect.DeepEqual(call_sym10.Parameters.D, d) {
-			found_sym10 = true
+	var found_sym12 bool
+	for _, call_sym12 := range f_sym12.MixedVariadicCalls {
+		if reflect.DeepEqual(call_sym12.Parameters.A, a) && reflect.DeepEqual(call_sym12.Parameters.B, b) && reflect.DeepEqual(call_sym12.Parameters.C, c) && reflect.DeepEqual(call_sym12.Parameters.D, d) {
+			found_sym12 = true
 			break
 		}
 	}
 
-	if !found_sym10 {
+	if !found_sym12 {
 		t.Error("FakeVariadic.MixedVariadic not called with expected parameters")
 	}
 }
 
 // MixedVariadicCalledOnceWith returns true if FakeVariadic.MixedVariadic was called exactly once with the given values
-func (f_sym11 *FakeVariadic) MixedVariadicCalledOnceWith(a int, b int, c int, d ...string) bool {
-	var count_sym11 int
-	for _, call_sym11 := range f_sym11.MixedVariadicCalls {
-		if reflect.DeepEqual(call_sym11.Parameters.A, a) && reflect.DeepEqual(call_sym11.Parameters.B, b) && reflect.DeepEqual(call_sym11.Parameters.C, c) && reflect.DeepEqual(call_sym11.Parameters.D, d) {
-			count_sym11++
+func (f_sym13 *FakeVariadic) MixedVariadicCalledOnceWith(a int, b int, c int, d ...string) bool {
+	var count_sym13 int
+	for _, call_sym13 := range f_sym13.MixedVariadicCalls {
+		if reflect.DeepEqual(call_sym13.Parameters.A, a) && reflect.DeepEqual(call_sym13.Parameters.B, b) && reflect.DeepEqual(call_sym13.Parameters.C, c) && reflect.DeepEqual(call_sym13.Parameters.D, d) {
+			count_sym13++
 		}
 	}
 
-	return count_sym11 == 1
+	return count_sym13 == 1
 }
 
 // AssertMixedVariadicCalledOnceWith calls t.Error if FakeVariadic.MixedVariadic was not called exactly once with the given values
-func (f_sym12 *FakeVariadic) AssertMixedVariadicCalledOnceWith(t VariadicTestingT, a int, b int, c int, d ...string) {
+func (f_sym14 *FakeVariadic) AssertMixedVariadicCalledOnceWith(t VariadicTestingT, a int, b int, c int, d ...string) {
 	t.Helper()
-	var count_sym12 int
-	for _, call_sym12 := range f_sym12.MixedVariadicCalls {
-		if reflect.DeepEqual(call_sym12.Parameters.A, a) && reflect.DeepEqual(call_sym12.Parameters.B, b) && reflect.DeepEqual(call_sym12.Parameters.C, c) && reflect.DeepEqual(call_sym12.Parameters.D, d) {
-			count_sym12++
+	var count_sym14 int
+	for _, call_sym14 := range f_sym14.MixedVariadicCalls {
+		if reflect.DeepEqual(call_sym14.Parameters.A, a) && reflect.DeepEqual(call_sym14.Parameters.B, b) && reflect.DeepEqual(call_sym14.Parameters.C, c) && reflect.DeepEqual(call_sym14.Parameters.D, d) {
+			count_sym14++
 		}
 	}
 
-	if count_sym12 != 1 {
-		t.Errorf("FakeVariadic.MixedVariadic called %d times with expected parameters, expected one", count_sym12)
+	if count_sym14 != 1 {
+		t.Errorf("FakeVariadic.MixedVariadic called %d times with expected parameters, expected one", count_sym14)
 	}
 }
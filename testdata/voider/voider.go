@@ -1,4 +1,4 @@
-// generated by "charlatan -dir=testdata/voider -output=testdata/voider/voider.go Voider".  DO NOT EDIT.
+// Code generated by "charlatan -dir=testdata/voider -output=testdata/voider/voider.go Voider".  DO NOT EDIT.
 
 package main
 
@@ -30,13 +30,13 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeVoidMethod ...
+		// assert state of FakeVoider ...
 		f.AssertVoidMethodCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeVoidMethod.
+unexpected calls are made to FakeVoider.
 */
 type FakeVoider struct {
 	VoidMethodHook func()
@@ -44,6 +44,8 @@ type FakeVoider struct {
 	VoidMethodCalls []*VoiderVoidMethodInvocation
 }
 
+var _ Voider = (*FakeVoider)(nil)
+
 // NewFakeVoiderDefaultPanic returns an instance of FakeVoider with all hooks configured to panic
 func NewFakeVoiderDefaultPanic() *FakeVoider {
 	return &FakeVoider{
@@ -73,19 +75,46 @@ func NewFakeVoiderDefaultError(t_sym2 VoiderTestingT) *FakeVoider {
 	}
 }
 
+// NewFakeVoiderDefaultZero returns an instance of FakeVoider with all hooks configured to return zero values
+func NewFakeVoiderDefaultZero() *FakeVoider {
+	return &FakeVoider{
+		VoidMethodHook: func() {
+			return
+		},
+	}
+}
+
+// NewFakeVoiderDefaultErr returns an instance of FakeVoider with all hooks configured to return zero
+// values, and the given error from the methods whose last result is an error
+func NewFakeVoiderDefaultErr(err_sym3 error) *FakeVoider {
+	return &FakeVoider{
+		VoidMethodHook: func() {
+			return
+		},
+	}
+}
+
+// NewFakeVoiderSpy returns an instance of FakeVoider with all hooks configured to call the given
+// implementation, so that its calls are recorded.  Hooks can be replaced to override the calls of some methods.
+func NewFakeVoiderSpy(real_sym4 Voider) *FakeVoider {
+	return &FakeVoider{
+		VoidMethodHook: real_sym4.VoidMethod,
+	}
+}
+
 func (f *FakeVoider) Reset() {
 	f.VoidMethodCalls = []*VoiderVoidMethodInvocation{}
 }
 
-func (f_sym3 *FakeVoider) VoidMethod() {
-	if f_sym3.VoidMethodHook == nil {
+func (f_sym5 *FakeVoider) VoidMethod() {
+	if f_sym5.VoidMethodHook == nil {
 		panic("Voider.VoidMethod() called but FakeVoider.VoidMethodHook is nil")
 	}
 
-	invocation_sym3 := new(VoiderVoidMethodInvocation)
-	f_sym3.VoidMethodCalls = append(f_sym3.VoidMethodCalls, invocation_sym3)
+	invocation_sym5 := new(VoiderVoidMethodInvocation)
+	f_sym5.VoidMethodCalls = append(f_sym5.VoidMethodCalls, invocation_sym5)
 
-	f_sym3.VoidMethodHook()
+	f_sym5.VoidMethodHook()
 
 	return
 }
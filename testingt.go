@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// TestingT selects the type of the testing parameter of the default constructors and the Assert* methods
+type TestingT string
+
+const (
+	// TestingTInterface declares a TestingT interface for each faked interface, e.g. ServiceTestingT
+	TestingTInterface TestingT = "interface"
+	// TestingTShared declares a single TestingT interface shared by the fakes of the output package
+	TestingTShared TestingT = "shared"
+	// TestingTB uses testing.TB, which imports the testing package
+	TestingTB TestingT = "tb"
+)
+
+// ParseTestingT returns the TestingT selected by name, the default is TestingTInterface
+func ParseTestingT(name string) (TestingT, error) {
+	switch t := TestingT(strings.TrimSpace(name)); t {
+	case "":
+		return TestingTInterface, nil
+	case TestingTInterface, TestingTShared, TestingTB:
+		return t, nil
+	}
+
+	return "", fmt.Errorf("error: unknown testing type %q, expected one of: %s,%s,%s", name, TestingTInterface, TestingTShared, TestingTB)
+}
+
+// Type returns the type of the testing parameter of the fakes of the named interface
+func (t TestingT) Type(interfaceName string) string {
+	switch t {
+	case TestingTShared:
+		return "TestingT"
+	case TestingTB:
+		return "testing.TB"
+	}
+
+	return interfaceName + "TestingT"
+}
+
+// CleanupType returns the type of the testing parameter of the strict constructor of the named interface, which
+// must also register cleanup functions
+func (t TestingT) CleanupType(interfaceName string) string {
+	if t == TestingTB {
+		return t.Type(interfaceName)
+	}
+
+	return "interface {\n\t" + t.Type(interfaceName) + "\n\tCleanup(func())\n}"
+}
+
+// Shared returns true if the TestingT interface is declared once for all fakes
+func (t TestingT) Shared() bool {
+	return t == TestingTShared
+}
+
+// PerInterface returns true if a TestingT interface is declared for each fake
+func (t TestingT) PerInterface() bool {
+	return t == "" || t == TestingTInterface
+}
+
+// sharedDeclared returns the names declared by the shared section of the template that a Go file of the output
+// package, other than the output file itself, already declares.  The test files of the package are included if the
+// output is a test file.
+func (g *Generator) sharedDeclared(output string) (map[string]bool, error) {
+	if output == "" {
+		return nil, nil
+	}
+
+	common := charlatanTemplate{
+		PackageName: g.outputPackageName(),
+		Shared:      true,
+		Features:    g.Features,
+		TestingT:    g.TestingT,
+		template:    g.Template,
+	}
+	src, err := common.execute()
+	if err != nil {
+		return nil, err
+	}
+	shared := declaredNames(src)
+	if len(shared) == 0 {
+		return nil, nil
+	}
+
+	output, err = filepath.Abs(output)
+	if err != nil {
+		return nil, err
+	}
+	filenames, err := filepath.Glob(filepath.Join(filepath.Dir(output), "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	tests := strings.HasSuffix(output, "_test.go")
+	declared := make(map[string]bool)
+	for _, filename := range filenames {
+		if filename == output || !tests && strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.PackageClauseOnly)
+		if err != nil || file.Name.Name != g.outputPackageName() {
+			// N.B. - a file that does not parse is reported when the package is loaded or the output validated
+			continue
+		}
+		file, err = parser.ParseFile(token.NewFileSet(), filename, nil, parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+		for _, name := range fileDeclaredNames(file) {
+			if shared[name] {
+				declared[name] = true
+			}
+		}
+	}
+
+	return declared, nil
+}
+
+// removeDeclarations removes the top level declarations of the names from the source, with their doc comments and
+// methods.  Source that does not parse is returned unchanged, its error is reported when it is formatted.
+func removeDeclarations(src []byte, names map[string]bool) []byte {
+	fileset := token.NewFileSet()
+	file, err := parser.ParseFile(fileset, "", src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return src
+	}
+
+	var buf bytes.Buffer
+	last := 0
+	for _, decl := range file.Decls {
+		start := decl.Pos()
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			name := decl.Name.Name
+			if decl.Recv != nil && len(decl.Recv.List) != 0 {
+				name = receiverName(decl.Recv.List[0].Type)
+			}
+			if !names[name] {
+				continue
+			}
+			if decl.Doc != nil {
+				start = decl.Doc.Pos()
+			}
+		case *ast.GenDecl:
+			if decl.Tok == token.IMPORT || !declaresOnly(decl, names) {
+				continue
+			}
+			if decl.Doc != nil {
+				start = decl.Doc.Pos()
+			}
+		}
+		buf.Write(src[last:fileset.Position(start).Offset])
+		last = fileset.Position(decl.End()).Offset
+	}
+	buf.Write(src[last:])
+
+	return buf.Bytes()
+}
+
+// declaresOnly returns true if the declaration only declares some of the names
+func declaresOnly(decl *ast.GenDecl, names map[string]bool) bool {
+	file := &ast.File{Decls: []ast.Decl{decl}}
+	declared := fileDeclaredNames(file)
+	for _, name := range declared {
+		if !names[name] {
+			return false
+		}
+	}
+
+	return len(declared) != 0
+}
+
+// declaredNames returns the top level names declared by the source
+func declaredNames(src []byte) map[string]bool {
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	for _, name := range fileDeclaredNames(file) {
+		names[name] = true
+	}
+
+	return names
+}
+
+// fileDeclaredNames returns the top level names declared by the file, excluding methods
+func fileDeclaredNames(file *ast.File) []string {
+	var names []string
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				names = append(names, decl.Name.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, spec.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						if name.Name != "_" {
+							names = append(names, name.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return names
+}
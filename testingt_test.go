@@ -0,0 +1,124 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTestingT(t *testing.T) {
+	testingT, err := ParseTestingT("")
+	assert.Nil(t, err)
+	assert.Equal(t, TestingTInterface, testingT)
+
+	testingT, err = ParseTestingT("tb")
+	assert.Nil(t, err)
+	assert.Equal(t, TestingTB, testingT)
+
+	_, err = ParseTestingT("testing.T")
+	assert.EqualError(t, err, `error: unknown testing type "testing.T", expected one of: interface,shared,tb`)
+}
+
+func TestGenerateTestingT(t *testing.T) {
+	g, err := parsePackage("testdata/voider", []string{"testdata/voider/voider_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	g.TestingT = TestingTShared
+	src, err := g.Generate([]string{"Voider"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type TestingT interface {")
+	assert.Contains(t, string(src), "AssertVoidMethodCalled(t TestingT)")
+	assert.NotContains(t, string(src), "VoiderTestingT")
+
+	files, err := g.GenerateFiles([]string{"Voider"}, "fake_{{.Name | lower}}.go")
+	assert.Nil(t, err)
+	assert.Contains(t, string(files[commonFilename]), "type TestingT interface {")
+	assert.NotContains(t, string(files["fake_voider.go"]), "type TestingT interface {")
+
+	g.TestingT = TestingTB
+	src, err = g.Generate([]string{"Voider"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), `import "testing"`)
+	assert.Contains(t, string(src), "AssertVoidMethodCalled(t testing.TB)")
+	assert.NotContains(t, string(src), "TestingT interface")
+
+	g.Features = Features{Stubs: true}
+	src, err = g.Generate([]string{"Voider"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), `import "testing"`)
+}
+
+func TestGenerateSharedDeclared(t *testing.T) {
+	g, err := parsePackage("testdata/voider", []string{"testdata/voider/voider_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	g.TestingT = TestingTShared
+
+	dir := t.TempDir()
+	g.Output = filepath.Join(dir, "fake_voider.go")
+	src, err := g.Generate([]string{"Voider"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type TestingT interface {")
+
+	// N.B. - the output itself is replaced, so its declarations do not count
+	if err := ioutil.WriteFile(g.Output, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+	src, err = g.Generate([]string{"Voider"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type TestingT interface {")
+
+	g.Output = filepath.Join(dir, "fake_other.go")
+	src, err = g.Generate([]string{"Voider"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "type TestingT interface {")
+	assert.Contains(t, string(src), "AssertVoidMethodCalled(t TestingT)")
+
+	g.Output = dir
+	files, err := g.GenerateFiles([]string{"Voider"}, "fake_{{.Name | lower}}.go")
+	assert.Nil(t, err)
+	assert.NotContains(t, files, commonFilename)
+}
+
+func TestGenerateSharedDeclaredNames(t *testing.T) {
+	g, err := parsePackage("testdata/voider", []string{"testdata/voider/voider_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	g.TestingT = TestingTShared
+	g.Features = AllFeatures
+
+	dir := t.TempDir()
+	testingT := []byte("package main\n\n// TestingT is declared by hand\ntype TestingT interface {\n\tError(...interface{})\n}\n")
+	if err := ioutil.WriteFile(filepath.Join(dir, "testing.go"), testingT, 0644); err != nil {
+		t.Fatal(err)
+	}
+	g.Output = filepath.Join(dir, "a_test.go")
+	src, err := g.Generate([]string{"Voider"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "type TestingT interface {")
+	assert.NotContains(t, string(src), "TestingT is declared")
+	assert.Contains(t, string(src), "type CharlatanExhausted func(method string, results int)")
+	assert.Contains(t, string(src), "func (m CharlatanMatcherFunc) Match(value interface{}) bool {")
+	if err := ioutil.WriteFile(g.Output, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// N.B. - the declarations of the test files count only for other test files
+	g.Output = filepath.Join(dir, "b_test.go")
+	src, err = g.Generate([]string{"Voider"})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(src), "type CharlatanExhausted")
+	assert.NotContains(t, string(src), "CharlatanMatcherFunc) Match")
+	assert.Contains(t, string(src), "AssertVoidMethodCalled(t TestingT)")
+
+	g.Output = filepath.Join(dir, "b.go")
+	src, err = g.Generate([]string{"Voider"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type CharlatanExhausted func(method string, results int)")
+}
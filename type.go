@@ -20,6 +20,8 @@ type Type interface {
 type BasicType struct {
 	Name            string
 	Qualifier       string
+	Path            string // import path of the qualifier, if any
+	underlying      string // the kind of the underlying type if it cannot be encoded, "func", "chan" or "interface"
 	parameterFormat string
 	fieldFormat     string
 }
@@ -113,11 +115,12 @@ func unwrapType(t types.Type, imports *ImportSet) (r Type, err error) {
 		}
 		r = &Pointer{subType: subType}
 	case *types.Interface, *types.Struct, *types.Signature:
-		r = &BasicType{Name: actual.String()}
+		r = &BasicType{Name: actual.String(), underlying: underlyingKind(actual)}
 	case *types.Named:
-		b := &BasicType{Name: actual.Obj().Name()}
+		b := &BasicType{Name: actual.Obj().Name(), underlying: underlyingKind(actual.Underlying())}
 		if actual.Obj().Pkg() != nil {
 			b.Qualifier = actual.Obj().Pkg().Name()
+			b.Path = actual.Obj().Pkg().Path()
 			imports.RequireByName(b.Qualifier)
 		}
 		r = b
@@ -129,3 +132,132 @@ func unwrapType(t types.Type, imports *ImportSet) (r Type, err error) {
 
 	return
 }
+
+// underlyingKind returns the kind of an underlying type that cannot be encoded, or the empty string
+func underlyingKind(t types.Type) string {
+	switch actual := t.(type) {
+	case *types.Signature:
+		return "func"
+	case *types.Chan:
+		return "chan"
+	case *types.Interface:
+		if actual.NumMethods() != 0 {
+			return "interface"
+		}
+	}
+
+	return ""
+}
+
+// qualifyType returns the type with the named types declared in the interface's package, as reported by local,
+// qualified by the package's name, so that it can be used in another package.  Type literals are not rewritten.
+func qualifyType(t Type, qualifier, path string, local func(name string) bool) Type {
+	switch actual := t.(type) {
+	case *BasicType:
+		if actual.Qualifier == "" && local(actual.Name) {
+			return &BasicType{Name: actual.Name, Qualifier: qualifier, Path: path, underlying: actual.underlying}
+		}
+	case *Pointer:
+		return &Pointer{subType: qualifyType(actual.subType, qualifier, path, local)}
+	case *Array:
+		return &Array{subType: qualifyType(actual.subType, qualifier, path, local), scale: actual.scale}
+	case *Ellipsis:
+		return &Ellipsis{subType: qualifyType(actual.subType, qualifier, path, local)}
+	case *Map:
+		return &Map{keyType: qualifyType(actual.keyType, qualifier, path, local), subType: qualifyType(actual.subType, qualifier, path, local)}
+	case *Channel:
+		return &Channel{subType: qualifyType(actual.subType, qualifier, path, local)}
+	case *SendChannel:
+		return &SendChannel{subType: qualifyType(actual.subType, qualifier, path, local)}
+	case *ReceiveChannel:
+		return &ReceiveChannel{subType: qualifyType(actual.subType, qualifier, path, local)}
+	}
+
+	return t
+}
+
+// annotateType records the kinds of the underlying types, and the packages other than local, of the named types in t,
+// a type parsed from source, taken from the same type as type checked
+func annotateType(t Type, checked types.Type, local *types.Package) {
+	switch actual := t.(type) {
+	case *BasicType:
+		if named, ok := checked.(*types.Named); ok {
+			actual.underlying = underlyingKind(named.Underlying())
+			// N.B. - the types of dot imports are not qualified, their package is only known once type checked
+			if pkg := named.Obj().Pkg(); actual.Path == "" && pkg != nil && pkg != local {
+				actual.Path = pkg.Path()
+			}
+		}
+	case *Pointer:
+		if pointer, ok := checked.(*types.Pointer); ok {
+			annotateType(actual.subType, pointer.Elem(), local)
+		}
+	case *Array:
+		switch elem := checked.(type) {
+		case *types.Slice:
+			annotateType(actual.subType, elem.Elem(), local)
+		case *types.Array:
+			annotateType(actual.subType, elem.Elem(), local)
+		}
+	case *Ellipsis:
+		if slice, ok := checked.(*types.Slice); ok {
+			annotateType(actual.subType, slice.Elem(), local)
+		}
+	case *Map:
+		if m, ok := checked.(*types.Map); ok {
+			annotateType(actual.keyType, m.Key(), local)
+			annotateType(actual.subType, m.Elem(), local)
+		}
+	case *Channel:
+		if ch, ok := checked.(*types.Chan); ok {
+			annotateType(actual.subType, ch.Elem(), local)
+		}
+	case *SendChannel:
+		if ch, ok := checked.(*types.Chan); ok {
+			annotateType(actual.subType, ch.Elem(), local)
+		}
+	case *ReceiveChannel:
+		if ch, ok := checked.(*types.Chan); ok {
+			annotateType(actual.subType, ch.Elem(), local)
+		}
+	}
+}
+
+// typePackages records the import paths of the packages of the named types referred to by t
+func typePackages(t types.Type, paths map[string]bool) {
+	switch actual := t.(type) {
+	case *types.Named:
+		if pkg := actual.Obj().Pkg(); pkg != nil {
+			paths[pkg.Path()] = true
+		}
+		for i := 0; i < actual.TypeArgs().Len(); i++ {
+			typePackages(actual.TypeArgs().At(i), paths)
+		}
+	case *types.Pointer:
+		typePackages(actual.Elem(), paths)
+	case *types.Slice:
+		typePackages(actual.Elem(), paths)
+	case *types.Array:
+		typePackages(actual.Elem(), paths)
+	case *types.Chan:
+		typePackages(actual.Elem(), paths)
+	case *types.Map:
+		typePackages(actual.Key(), paths)
+		typePackages(actual.Elem(), paths)
+	case *types.Tuple:
+		for i := 0; i < actual.Len(); i++ {
+			typePackages(actual.At(i).Type(), paths)
+		}
+	case *types.Signature:
+		typePackages(actual.Params(), paths)
+		typePackages(actual.Results(), paths)
+	case *types.Struct:
+		for i := 0; i < actual.NumFields(); i++ {
+			typePackages(actual.Field(i).Type(), paths)
+		}
+	case *types.Interface:
+		for i := 0; i < actual.NumMethods(); i++ {
+			typePackages(actual.Method(i).Type(), paths)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// Validate type checks generated source files, keyed by their output paths, against the package they were generated
+// from.  Source generated for another package is checked with the other files of its directory, importing the package
+// it was generated from.  Test outputs are checked with the test files of their package.  Errors are reported with
+// the interface method whose generated code caused them.
+func (g *Generator) Validate(interfaceNames []string, outputs map[string][]byte) error {
+	decls, err := g.resolve(interfaceNames)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(outputs))
+	for path := range outputs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	generated := make([]*ast.File, 0, len(outputs))
+	for _, path := range paths {
+		file, err := parser.ParseFile(g.fileset, path, outputs[path], 0)
+		if err != nil {
+			return fmt.Errorf("internal error: invalid code generated: %s", err)
+		}
+		generated = append(generated, file)
+	}
+
+	_, stdout := outputs["-"]
+	tests := false
+	for _, path := range paths {
+		tests = tests || strings.HasSuffix(path, "_test.go")
+	}
+	files := append([]*ast.File{}, generated...)
+	importer := g.importer
+	path := g.directory
+	if g.outputPackageName() == g.packageName {
+		for _, file := range g.files {
+			filename := g.fileset.File(file.Pos()).Name()
+			// N.B. - source written to standard output is assumed to replace the previously generated fakes
+			if isOutput(filename, paths) || stdout && g.generated[filename] {
+				continue
+			}
+			files = append(files, file)
+		}
+		if tests {
+			files = append(files, g.parsePackageFiles(filepath.Join(g.directory, "*_test.go"), g.packageName, paths, true)...)
+		}
+	} else {
+		source, err := packageImportPath(g.directory)
+		if err != nil {
+			return err
+		}
+		importer = &sourceImporter{path: source, pkg: g.pkg, importer: g.importer}
+		path = g.outputPackageName()
+		if !stdout {
+			files = append(files, g.parsePackageFiles(filepath.Join(filepath.Dir(paths[0]), "*.go"), path, paths, tests)...)
+		}
+	}
+
+	var errs []string
+	// primary is the last error not continued by a tab indented message, if it was not reported
+	var primary string
+	config := types.Config{
+		Importer: importer,
+		Error: func(err error) {
+			typeErr, ok := err.(types.Error)
+			if !ok {
+				return
+			}
+			continued := strings.HasPrefix(typeErr.Msg, "\t")
+			if !continued {
+				primary = fmt.Sprintf("%s: %s", g.fileset.Position(typeErr.Pos), typeErr.Msg)
+			}
+			for _, file := range generated {
+				if g.fileset.File(file.Pos()) == g.fileset.File(typeErr.Pos) {
+					// N.B. - e.g. a declaration redeclared by the generated code is reported in the other file
+					if continued && primary != "" {
+						errs = append(errs, primary)
+					}
+					errs = append(errs, g.describeError(file, typeErr, decls))
+					primary = ""
+					return
+				}
+			}
+		},
+	}
+	config.Check(path, g.fileset, files, nil)
+
+	if len(errs) != 0 {
+		return fmt.Errorf("invalid code generated:\n\t%s", strings.Join(errs, "\n\t"))
+	}
+
+	return nil
+}
+
+// sourceImporter imports the package the fakes were generated from as it was loaded, e.g. with a file read from
+// standard input, and other packages with the default importer
+type sourceImporter struct {
+	path     string
+	pkg      *types.Package
+	importer types.Importer
+}
+
+func (i *sourceImporter) Import(path string) (*types.Package, error) {
+	if path == i.path {
+		return i.pkg, nil
+	}
+
+	return i.importer.Import(path)
+}
+
+// parsePackageFiles parses the files of the named package matching the pattern other than the outputs, e.g. the shared
+// declarations of previous outputs.  Test files are included if tests is true.  Files that do not parse are left out,
+// they are reported by the go command.
+func (g *Generator) parsePackageFiles(pattern, name string, paths []string, tests bool) []*ast.File {
+	filenames, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil
+	}
+
+	var files []*ast.File
+	for _, filename := range filenames {
+		if isOutput(filename, paths) || !tests && strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(g.fileset, filename, nil, 0)
+		if err != nil || file.Name.Name != name {
+			continue
+		}
+		files = append(files, file)
+	}
+
+	return files
+}
+
+func isOutput(filename string, paths []string) bool {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return false
+	}
+	for _, path := range paths {
+		if other, err := filepath.Abs(path); err == nil && other == abs {
+			return true
+		}
+	}
+
+	return false
+}
+
+// describeError prefixes the error with the interface method whose generated declaration contains it, if any
+func (g *Generator) describeError(file *ast.File, typeErr types.Error, decls []*Interface) string {
+	message := fmt.Sprintf("%s: %s", g.fileset.Position(typeErr.Pos), typeErr.Msg)
+
+	method := findMethod(file, typeErr.Pos, decls)
+	if method == nil {
+		return message
+	}
+
+	return fmt.Sprintf("%s.%s: %s", method.Interface, method.Name, message)
+}
+
+// findMethod returns the interface method whose generated code encloses the given position
+func findMethod(file *ast.File, pos token.Pos, decls []*Interface) *Method {
+	names := make(map[string]*Method)
+	fakes := make(map[string][]*Method)
+	for _, decl := range decls {
+		for _, m := range decl.Methods {
+			names[m.InvocationName()] = m
+			names[m.InvocationConstructorName()] = m
+			names[m.HookName()] = m
+			names[m.CallsName()] = m
+		}
+		fakes[decl.FakeName()] = decl.Methods
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, pos, pos)
+	for _, node := range path {
+		switch n := node.(type) {
+		case *ast.KeyValueExpr:
+			if key, ok := n.Key.(*ast.Ident); ok && names[key.Name] != nil {
+				return names[key.Name]
+			}
+		case *ast.Field:
+			for _, name := range n.Names {
+				if names[name.Name] != nil {
+					return names[name.Name]
+				}
+			}
+		case *ast.TypeSpec:
+			if names[n.Name.Name] != nil {
+				return names[n.Name.Name]
+			}
+		case *ast.FuncDecl:
+			if names[n.Name.Name] != nil {
+				return names[n.Name.Name]
+			}
+			if n.Recv == nil || len(n.Recv.List) == 0 {
+				return nil
+			}
+			// N.B. - helper methods of the fake contain the name of the method, prefer the longest match
+			var found *Method
+			for _, m := range fakes[receiverName(n.Recv.List[0].Type)] {
+				if strings.Contains(n.Name.Name, m.Name) && (found == nil || len(m.Name) > len(found.Name)) {
+					found = m
+				}
+			}
+			return found
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	g, err := parsePackage("testdata/multireturner", []string{"testdata/multireturner/multireturner_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	src, err := g.Generate([]string{"Multireturner"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	err = g.Validate([]string{"Multireturner"}, map[string][]byte{"testdata/multireturner/multireturner.go": src})
+	assert.Nil(t, err)
+
+	broken := bytes.Replace(src, []byte("return len(f.NamedReturnCalls) != 0"), []byte("return len(f.NamedReturnCalls)"), 1)
+	err = g.Validate([]string{"Multireturner"}, map[string][]byte{"testdata/multireturner/multireturner.go": broken})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Multireturner.NamedReturn: testdata/multireturner/multireturner.go:")
+	}
+}
+
+func TestValidateSyntaxError(t *testing.T) {
+	g, err := parsePackage("testdata/multireturner", []string{"testdata/multireturner/multireturner_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+
+	err = g.Validate([]string{"Multireturner"}, map[string][]byte{"charlatan.go": []byte("package main\nfunc {")})
+	assert.Error(t, err)
+}
+
+func TestValidateOtherPackage(t *testing.T) {
+	g, err := parsePackage("testdata/recorder", []string{"testdata/recorder/recorder_def.go"})
+	if err != nil {
+		t.Fatalf("parsePackage error: %s", err)
+	}
+	g.PackageOverride = "mocks"
+	output := filepath.Join(t.TempDir(), "recorder.go")
+	src, err := g.Generate([]string{"Recorder"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+	assert.Contains(t, string(src), "item *main.Item")
+
+	err = g.Validate([]string{"Recorder"}, map[string][]byte{output: src})
+	assert.Nil(t, err)
+
+	broken := bytes.Replace(src, []byte("item *main.Item, found bool, err error) *RecorderLookupInvocation"), []byte("item *Item, found bool, err error) *RecorderLookupInvocation"), 1)
+	err = g.Validate([]string{"Recorder"}, map[string][]byte{output: broken})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Recorder.Lookup: "+output+":")
+		assert.Contains(t, err.Error(), "undefined: Item")
+	}
+
+	err = g.Validate([]string{"Recorder"}, map[string][]byte{"-": broken})
+	assert.Error(t, err)
+}
+
+func TestValidateStandardOutput(t *testing.T) {
+	g, err := LoadPackageDir("testdata/multireturner")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+	src, err := g.Generate([]string{"Multireturner"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	err = g.Validate([]string{"Multireturner"}, map[string][]byte{"-": src})
+	assert.Nil(t, err)
+
+	broken := bytes.Replace(src, []byte("return len(f.NamedReturnCalls) != 0"), []byte("return len(f.NamedReturnCalls)"), 1)
+	err = g.Validate([]string{"Multireturner"}, map[string][]byte{"-": broken})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Multireturner.NamedReturn: -:")
+	}
+}
+
+func TestValidateTestOutput(t *testing.T) {
+	dir := t.TempDir()
+	def, err := ioutil.ReadFile("testdata/multireturner/multireturner_def.go")
+	if err != nil {
+		t.Fatalf("ReadFile error: %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "multireturner_def.go"), def, 0644); err != nil {
+		t.Fatalf("WriteFile error: %s", err)
+	}
+	other := []byte("package main\n\ntype FakeMultireturner struct{}\n")
+	if err := ioutil.WriteFile(filepath.Join(dir, "other_test.go"), other, 0644); err != nil {
+		t.Fatalf("WriteFile error: %s", err)
+	}
+	g, err := LoadPackageDir(dir)
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+	src, err := g.Generate([]string{"Multireturner"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	err = g.Validate([]string{"Multireturner"}, map[string][]byte{filepath.Join(dir, "fake.go"): src})
+	assert.Nil(t, err)
+
+	err = g.Validate([]string{"Multireturner"}, map[string][]byte{filepath.Join(dir, "fake_test.go"): src})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), filepath.Join(dir, "other_test.go")+":3:6: FakeMultireturner redeclared in this block")
+		assert.Contains(t, err.Error(), "other declaration of FakeMultireturner")
+	}
+}